@@ -0,0 +1,244 @@
+package internal_logger
+
+import (
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/proto"
+)
+
+// 缓冲区满时的处理策略
+const (
+	OverflowBlock      = "block"       // 阻塞等待写入
+	OverflowDropOldest = "drop_oldest" // 丢弃最早的日志
+	OverflowDropNewest = "drop_newest" // 丢弃当前日志
+)
+
+// asyncBatchSize 后台协程每次从缓冲区取出的最大条数
+const asyncBatchSize = 128
+
+// AsyncLoggerConfig 异步写入配置
+type AsyncLoggerConfig struct {
+	BufferSize      int           // 缓冲区大小，不大于 0 时为 4096
+	Overflow        string        // 缓冲区满时的处理策略，默认 OverflowDropNewest
+	NeverDropErrors bool          // 缓冲区满时不丢弃 error 及以上级别的日志，改为阻塞等待
+	FlushTimeout    time.Duration // 关闭时等待缓冲区写完的超时时间，不大于 0 时一直等待
+	Dropped         *atomic.Int64 // 累计丢弃的日志条数，由调用方持有，重新创建后继续累加
+}
+
+type asyncEntry struct {
+	level   log.Level
+	keyvals []any
+}
+
+// AsyncLogger 异步写入的日志器
+// 日志先写入环形缓冲区，由后台协程写入底层日志器，磁盘 IO 阻塞时不影响调用方
+// 入队时复制 keyvals 中可变的值（见 snapshotKeyvals），调用方在 Log 返回后修改不影响输出
+type AsyncLogger struct {
+	logger log.Logger
+	config AsyncLoggerConfig
+
+	mu       sync.Mutex
+	notEmpty *sync.Cond
+	notFull  *sync.Cond
+	drained  *sync.Cond
+	buf      []asyncEntry
+	head     int
+	size     int
+	writing  bool
+	closed   bool
+
+	done chan struct{}
+}
+
+// NewAsyncLogger 创建异步写入的日志器
+// 返回的释放函数会停止接收新的日志（之后的日志同步写入），并等待缓冲区写完或超时
+func NewAsyncLogger(logger log.Logger, config AsyncLoggerConfig) (*AsyncLogger, func()) {
+	if config.BufferSize <= 0 {
+		config.BufferSize = 4096
+	}
+	if config.Overflow == "" {
+		config.Overflow = OverflowDropNewest
+	}
+	if config.Dropped == nil {
+		config.Dropped = new(atomic.Int64)
+	}
+	a := &AsyncLogger{
+		logger: logger,
+		config: config,
+		buf:    make([]asyncEntry, config.BufferSize),
+		done:   make(chan struct{}),
+	}
+	a.notEmpty = sync.NewCond(&a.mu)
+	a.notFull = sync.NewCond(&a.mu)
+	a.drained = sync.NewCond(&a.mu)
+	go a.run()
+	return a, a.close
+}
+
+func (a *AsyncLogger) Log(level log.Level, keyvals ...any) error {
+	// fatal 日志输出后进程会退出，等待缓冲区写完后同步写入
+	if level >= log.LevelFatal {
+		a.mu.Lock()
+		for (a.size > 0 || a.writing) && !a.closed {
+			a.drained.Wait()
+		}
+		a.mu.Unlock()
+		return a.logger.Log(level, keyvals...)
+	}
+
+	a.mu.Lock()
+	for !a.closed && a.size == len(a.buf) {
+		keep := a.config.NeverDropErrors && level >= log.LevelError
+		if keep || a.config.Overflow == OverflowBlock {
+			a.notFull.Wait()
+			continue
+		}
+		if a.config.Overflow == OverflowDropOldest && !(a.config.NeverDropErrors && a.buf[a.head].level >= log.LevelError) {
+			a.pop()
+			a.config.Dropped.Add(1)
+			continue
+		}
+		// drop_newest，或者最早的日志是不能丢弃的 error
+		a.mu.Unlock()
+		a.config.Dropped.Add(1)
+		return nil
+	}
+	if a.closed {
+		a.mu.Unlock()
+		return a.logger.Log(level, keyvals...)
+	}
+	a.buf[(a.head+a.size)%len(a.buf)] = asyncEntry{level, snapshotKeyvals(keyvals)}
+	a.size++
+	a.notEmpty.Signal()
+	a.mu.Unlock()
+	return nil
+}
+
+// Depth 缓冲区中等待写入的日志条数
+func (a *AsyncLogger) Depth() int {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.size
+}
+
+// Capacity 缓冲区大小
+func (a *AsyncLogger) Capacity() int {
+	return len(a.buf)
+}
+
+// pop 取出最早的日志，调用方需要持有锁
+func (a *AsyncLogger) pop() asyncEntry {
+	e := a.buf[a.head]
+	a.buf[a.head] = asyncEntry{}
+	a.head = (a.head + 1) % len(a.buf)
+	a.size--
+	return e
+}
+
+func (a *AsyncLogger) run() {
+	defer close(a.done)
+	batch := make([]asyncEntry, 0, asyncBatchSize)
+	for {
+		a.mu.Lock()
+		a.writing = false
+		if a.size == 0 {
+			a.drained.Broadcast()
+		}
+		for a.size == 0 && !a.closed {
+			a.notEmpty.Wait()
+		}
+		if a.size == 0 {
+			a.mu.Unlock()
+			return
+		}
+		for a.size > 0 && len(batch) < cap(batch) {
+			batch = append(batch, a.pop())
+		}
+		a.writing = true
+		a.notFull.Broadcast()
+		a.mu.Unlock()
+
+		for _, e := range batch {
+			_ = a.logger.Log(e.level, e.keyvals...)
+		}
+		clear(batch)
+		batch = batch[:0]
+	}
+}
+
+func (a *AsyncLogger) close() {
+	a.mu.Lock()
+	a.closed = true
+	a.notEmpty.Broadcast()
+	a.notFull.Broadcast()
+	a.drained.Broadcast()
+	a.mu.Unlock()
+
+	if a.config.FlushTimeout <= 0 {
+		<-a.done
+		return
+	}
+	select {
+	case <-a.done:
+	case <-time.After(a.config.FlushTimeout):
+	}
+}
+
+// snapshotKeyvals 复制 keyvals 及其中可变的值，后台协程编码时与调用方没有数据竞争
+func snapshotKeyvals(keyvals []any) []any {
+	snapshot := make([]any, len(keyvals))
+	for i, v := range keyvals {
+		snapshot[i] = snapshotValue(v)
+	}
+	return snapshot
+}
+
+// snapshotValue map、切片递归复制，proto 消息 Clone，Args 转换为输出的字符串
+// 其他指针类型无法通用地复制，仍然按引用写入
+func snapshotValue(v any) any {
+	switch vt := v.(type) {
+	case nil, string, bool, int, int64, int32, uint, uint64, uint32, float64, float32, time.Time, time.Duration, error:
+		return v
+	case Args:
+		return vt.String()
+	case proto.Message:
+		return proto.Clone(vt)
+	case map[string]any:
+		m := make(map[string]any, len(vt))
+		for k, item := range vt {
+			m[k] = snapshotValue(item)
+		}
+		return m
+	case []any:
+		l := make([]any, len(vt))
+		for i, item := range vt {
+			l[i] = snapshotValue(item)
+		}
+		return l
+	}
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Map:
+		if rv.IsNil() {
+			return v
+		}
+		m := reflect.MakeMapWithSize(rv.Type(), rv.Len())
+		for iter := rv.MapRange(); iter.Next(); {
+			m.SetMapIndex(iter.Key(), iter.Value())
+		}
+		return m.Interface()
+	case reflect.Slice:
+		if rv.IsNil() {
+			return v
+		}
+		l := reflect.MakeSlice(rv.Type(), rv.Len(), rv.Len())
+		reflect.Copy(l, rv)
+		return l.Interface()
+	default:
+		return v
+	}
+}
@@ -0,0 +1,182 @@
+package internal_logger
+
+import (
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// gateLogger 在 gate 关闭前阻塞写入，模拟磁盘 IO 阻塞
+type gateLogger struct {
+	recordLogger
+	once    sync.Once
+	started chan struct{}
+	gate    chan struct{}
+}
+
+func newGateLogger() *gateLogger {
+	return &gateLogger{started: make(chan struct{}), gate: make(chan struct{})}
+}
+
+func (l *gateLogger) Log(level log.Level, keyvals ...any) error {
+	l.once.Do(func() { close(l.started) })
+	<-l.gate
+	return l.recordLogger.Log(level, keyvals...)
+}
+
+func (l *gateLogger) msgs() []any {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	msgs := make([]any, len(l.records))
+	for i, r := range l.records {
+		msgs[i] = r.keyvals[1]
+	}
+	return msgs
+}
+
+// newBlockedAsyncLogger 创建异步日志器，后台协程写入第一条日志后阻塞，缓冲区为空
+func newBlockedAsyncLogger(t *testing.T, config AsyncLoggerConfig) (*AsyncLogger, func(), *gateLogger) {
+	t.Helper()
+	logger := newGateLogger()
+	a, release := NewAsyncLogger(logger, config)
+	_ = a.Log(log.LevelInfo, "msg", "first")
+	<-logger.started
+	for a.Depth() > 0 {
+		time.Sleep(time.Millisecond)
+	}
+	return a, release, logger
+}
+
+func TestAsyncLoggerDropOldest(t *testing.T) {
+	a, release, logger := newBlockedAsyncLogger(t, AsyncLoggerConfig{
+		BufferSize:      2,
+		Overflow:        OverflowDropOldest,
+		NeverDropErrors: true,
+	})
+
+	_ = a.Log(log.LevelInfo, "msg", "a")
+	_ = a.Log(log.LevelError, "msg", "b")
+	// 丢弃最早的 a
+	_ = a.Log(log.LevelInfo, "msg", "c")
+	// 最早的 b 是 error，不能丢弃，改为丢弃当前日志
+	_ = a.Log(log.LevelInfo, "msg", "d")
+	if dropped := a.config.Dropped.Load(); dropped != 2 {
+		t.Fatalf("dropped = %d, want 2", dropped)
+	}
+
+	// 缓冲区满时 error 阻塞等待，不丢弃
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_ = a.Log(log.LevelError, "msg", "e")
+	}()
+	select {
+	case <-done:
+		t.Fatal("error log was not blocked when buffer is full")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	close(logger.gate)
+	<-done
+	release()
+	if got, want := logger.msgs(), []any{"first", "b", "c", "e"}; !slices.Equal(got, want) {
+		t.Errorf("msgs = %v, want %v", got, want)
+	}
+	if dropped := a.config.Dropped.Load(); dropped != 2 {
+		t.Errorf("dropped = %d, want 2", dropped)
+	}
+}
+
+func TestAsyncLoggerDropNewest(t *testing.T) {
+	a, release, logger := newBlockedAsyncLogger(t, AsyncLoggerConfig{BufferSize: 1})
+
+	_ = a.Log(log.LevelInfo, "msg", "a")
+	_ = a.Log(log.LevelError, "msg", "b")
+	close(logger.gate)
+	release()
+	if got, want := logger.msgs(), []any{"first", "a"}; !slices.Equal(got, want) {
+		t.Errorf("msgs = %v, want %v", got, want)
+	}
+	if dropped := a.config.Dropped.Load(); dropped != 1 {
+		t.Errorf("dropped = %d, want 1", dropped)
+	}
+}
+
+func TestAsyncLoggerFlushOnRelease(t *testing.T) {
+	a, release, logger := newBlockedAsyncLogger(t, AsyncLoggerConfig{BufferSize: 8})
+
+	for _, msg := range []string{"a", "b", "c"} {
+		_ = a.Log(log.LevelInfo, "msg", msg)
+	}
+	if depth := a.Depth(); depth != 3 {
+		t.Fatalf("depth = %d, want 3", depth)
+	}
+	close(logger.gate)
+	// 释放时等待缓冲区写完
+	release()
+	if got, want := logger.msgs(), []any{"first", "a", "b", "c"}; !slices.Equal(got, want) {
+		t.Fatalf("msgs = %v, want %v", got, want)
+	}
+	// 释放后同步写入
+	_ = a.Log(log.LevelInfo, "msg", "d")
+	if got, want := logger.msgs(), []any{"first", "a", "b", "c", "d"}; !slices.Equal(got, want) {
+		t.Errorf("msgs = %v, want %v", got, want)
+	}
+}
+
+func TestAsyncLoggerCloseTimeout(t *testing.T) {
+	a, release, logger := newBlockedAsyncLogger(t, AsyncLoggerConfig{
+		BufferSize:   8,
+		FlushTimeout: 50 * time.Millisecond,
+	})
+	t.Cleanup(func() { close(logger.gate) })
+
+	_ = a.Log(log.LevelInfo, "msg", "a")
+	start := time.Now()
+	// 底层日志器一直阻塞，超时后返回
+	release()
+	if elapsed := time.Since(start); elapsed < 50*time.Millisecond || elapsed > time.Second {
+		t.Errorf("release took %v, want about 50ms", elapsed)
+	}
+	if depth := a.Depth(); depth != 1 {
+		t.Errorf("depth = %d, want 1", depth)
+	}
+}
+
+func TestAsyncLoggerSnapshotKeyvals(t *testing.T) {
+	a, release, logger := newBlockedAsyncLogger(t, AsyncLoggerConfig{BufferSize: 8})
+
+	m := map[string]any{"user": map[string]any{"name": "bob"}}
+	tags := []string{"a"}
+	req, err := structpb.NewStruct(map[string]any{"name": "bob"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := Args{Value: req}.String()
+	_ = a.Log(log.LevelInfo, "msg", "snapshot", "m", m, "tags", tags, "args", Args{Value: req}, "req", req)
+
+	// Log 返回后调用方继续修改
+	m["user"].(map[string]any)["name"] = "alice"
+	tags[0] = "b"
+	req.Fields["name"] = structpb.NewStringValue("alice")
+
+	close(logger.gate)
+	release()
+	r := logger.records[len(logger.records)-1].keyvals
+	if got := r[3].(map[string]any)["user"].(map[string]any)["name"]; got != "bob" {
+		t.Errorf("m.user.name = %v, want bob", got)
+	}
+	if got := r[5].([]string)[0]; got != "a" {
+		t.Errorf("tags[0] = %v, want a", got)
+	}
+	if got := r[7]; got != want {
+		t.Errorf("args = %v, want %v", got, want)
+	}
+	if got := r[9].(*structpb.Struct).Fields["name"].GetStringValue(); got != "bob" {
+		t.Errorf("req.name = %v, want bob", got)
+	}
+}
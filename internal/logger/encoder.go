@@ -0,0 +1,249 @@
+package internal_logger
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"go.uber.org/zap"
+	"go.uber.org/zap/buffer"
+	"go.uber.org/zap/zapcore"
+)
+
+// 日志编码
+const (
+	EncodingJSON    = "json"    // 每行一个 json 对象
+	EncodingLogfmt  = "logfmt"  // key=value 格式
+	EncodingConsole = "console" // 便于阅读的格式，用于本地开发
+)
+
+// 级别大小写
+const (
+	LevelCaseUpper = "upper"
+	LevelCaseLower = "lower"
+)
+
+// EncoderConfig 日志编码配置
+type EncoderConfig struct {
+	Encoding   string // 编码，默认 EncodingJSON
+	TimeKey    string // 时间戳的 key，值为 time.Time 时按 TimeFormat 格式化，并作为日志时间输出
+	TimeFormat string // 时间格式，默认 time.RFC3339
+	MessageKey string // 消息的 key，默认 msg
+	LevelKey   string // 级别的 key，默认 level
+	LevelCase  string // 级别大小写，默认 LevelCaseUpper
+	Color      bool   // console 编码时级别是否带颜色
+}
+
+// encoderEntry 从 keyvals 中取出的时间、消息和其他字段
+type encoderEntry struct {
+	level   log.Level
+	time    time.Time
+	msg     string
+	keyvals []any
+}
+
+// encoderLogger 按编码写入 io.Writer 的日志器，json 和 console 编码使用 zap 的 encoder
+type encoderLogger struct {
+	w      io.Writer
+	config EncoderConfig
+
+	// 日志没有时间戳时使用 zapNoTime，避免输出零值时间
+	zap       zapcore.Encoder
+	zapNoTime zapcore.Encoder
+
+	mu   sync.Mutex
+	pool sync.Pool
+}
+
+// NewEncoderLogger 创建按编码写入 w 的日志器，可以并发使用
+func NewEncoderLogger(w io.Writer, config EncoderConfig) log.Logger {
+	if config.Encoding == "" {
+		config.Encoding = EncodingJSON
+	}
+	if config.TimeFormat == "" {
+		config.TimeFormat = time.RFC3339
+	}
+	if config.MessageKey == "" {
+		config.MessageKey = log.DefaultMessageKey
+	}
+	if config.LevelKey == "" {
+		config.LevelKey = "level"
+	}
+	l := &encoderLogger{
+		w:      w,
+		config: config,
+		pool: sync.Pool{New: func() any {
+			return new(bytes.Buffer)
+		}},
+	}
+	if config.Encoding != EncodingLogfmt {
+		l.zap, l.zapNoTime = newZapEncoder(config)
+	}
+	return l
+}
+
+func newZapEncoder(config EncoderConfig) (zapcore.Encoder, zapcore.Encoder) {
+	encoderConfig := zap.NewProductionEncoderConfig()
+	encoderConfig.TimeKey = config.TimeKey
+	encoderConfig.MessageKey = config.MessageKey
+	encoderConfig.LevelKey = config.LevelKey
+	encoderConfig.EncodeTime = zapcore.TimeEncoderOfLayout(config.TimeFormat)
+	encoderConfig.EncodeDuration = zapcore.StringDurationEncoder
+	switch {
+	case config.Encoding == EncodingConsole && config.Color && config.LevelCase == LevelCaseLower:
+		encoderConfig.EncodeLevel = zapcore.LowercaseColorLevelEncoder
+	case config.Encoding == EncodingConsole && config.Color:
+		encoderConfig.EncodeLevel = zapcore.CapitalColorLevelEncoder
+	case config.LevelCase == LevelCaseLower:
+		encoderConfig.EncodeLevel = zapcore.LowercaseLevelEncoder
+	default:
+		encoderConfig.EncodeLevel = zapcore.CapitalLevelEncoder
+	}
+
+	newEncoder := zapcore.NewJSONEncoder
+	if config.Encoding == EncodingConsole {
+		newEncoder = zapcore.NewConsoleEncoder
+	}
+	noTimeConfig := encoderConfig
+	noTimeConfig.TimeKey = ""
+	return newEncoder(encoderConfig), newEncoder(noTimeConfig)
+}
+
+func (l *encoderLogger) Log(level log.Level, keyvals ...any) error {
+	if len(keyvals) == 0 {
+		return nil
+	}
+	if (len(keyvals) & 1) == 1 {
+		keyvals = append(keyvals, "KEYVALS UNPAIRED")
+	}
+	entry := l.entry(level, keyvals)
+
+	var data []byte
+	if l.zap != nil {
+		buf, err := l.encodeZap(entry)
+		if err != nil {
+			return err
+		}
+		defer buf.Free()
+		data = buf.Bytes()
+	} else {
+		buf := l.pool.Get().(*bytes.Buffer)
+		defer func() {
+			buf.Reset()
+			l.pool.Put(buf)
+		}()
+		l.encodeLogfmt(buf, entry)
+		data = buf.Bytes()
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	_, err := l.w.Write(data)
+	return err
+}
+
+// entry 取出时间戳和消息，其他字段保持原顺序
+func (l *encoderLogger) entry(level log.Level, keyvals []any) encoderEntry {
+	entry := encoderEntry{level: level, keyvals: make([]any, 0, len(keyvals))}
+	for i := 0; i < len(keyvals); i += 2 {
+		key := fmt.Sprint(keyvals[i])
+		switch key {
+		case l.config.TimeKey:
+			if t, ok := keyvals[i+1].(time.Time); ok {
+				entry.time = t
+				continue
+			}
+		case log.DefaultMessageKey:
+			entry.msg = fmt.Sprint(keyvals[i+1])
+			continue
+		}
+		entry.keyvals = append(entry.keyvals, key, keyvals[i+1])
+	}
+	return entry
+}
+
+func (l *encoderLogger) encodeZap(entry encoderEntry) (*buffer.Buffer, error) {
+	fields := make([]zap.Field, 0, len(entry.keyvals)/2)
+	for i := 0; i < len(entry.keyvals); i += 2 {
+		fields = append(fields, zap.Any(entry.keyvals[i].(string), entry.keyvals[i+1]))
+	}
+	encoder := l.zap
+	if entry.time.IsZero() {
+		encoder = l.zapNoTime
+	}
+	return encoder.EncodeEntry(zapcore.Entry{
+		Level:   zapLevel(entry.level),
+		Time:    entry.time,
+		Message: entry.msg,
+	}, fields)
+}
+
+// encodeLogfmt 按 level、时间、消息、其他字段的顺序输出 key=value
+func (l *encoderLogger) encodeLogfmt(buf *bytes.Buffer, entry encoderEntry) {
+	level := entry.level.String()
+	if l.config.LevelCase == LevelCaseLower {
+		level = strings.ToLower(level)
+	}
+	writeLogfmt(buf, l.config.LevelKey, level)
+	if !entry.time.IsZero() {
+		writeLogfmt(buf, l.config.TimeKey, entry.time.Format(l.config.TimeFormat))
+	}
+	writeLogfmt(buf, l.config.MessageKey, entry.msg)
+	for i := 0; i < len(entry.keyvals); i += 2 {
+		writeLogfmt(buf, entry.keyvals[i].(string), l.formatValue(entry.keyvals[i+1]))
+	}
+	buf.WriteByte('\n')
+}
+
+func (l *encoderLogger) formatValue(v any) string {
+	switch vt := v.(type) {
+	case string:
+		return vt
+	case time.Time:
+		return vt.Format(l.config.TimeFormat)
+	case error:
+		return vt.Error()
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func writeLogfmt(buf *bytes.Buffer, key, value string) {
+	if buf.Len() > 0 {
+		buf.WriteByte(' ')
+	}
+	buf.WriteString(key)
+	buf.WriteByte('=')
+	if needsQuote(value) {
+		buf.WriteString(strconv.Quote(value))
+	} else {
+		buf.WriteString(value)
+	}
+}
+
+// needsQuote 空值、包含空白、等号、引号或不可见字符时需要加引号
+func needsQuote(s string) bool {
+	if s == "" {
+		return true
+	}
+	for _, r := range s {
+		if r == '=' || r == '"' || unicode.IsSpace(r) || !unicode.IsPrint(r) {
+			return true
+		}
+	}
+	return false
+}
+
+// zapLevel kratos 的 LevelFatal 与 zap 的 DPanicLevel 数值相同，需要单独转换
+func zapLevel(level log.Level) zapcore.Level {
+	if level >= log.LevelFatal {
+		return zapcore.FatalLevel
+	}
+	return zapcore.Level(level)
+}
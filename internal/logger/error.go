@@ -0,0 +1,42 @@
+package internal_logger
+
+import (
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/jaggerzhuang1994/kratos-foundation/pkg/errors"
+)
+
+// errorLogger 将 value 中的 *errors.Error 展开为结构化字段
+type errorLogger struct {
+	logger log.Logger
+}
+
+// NewErrorLogger 创建错误展开日志器
+// value 的错误链中有 *errors.Error 时替换为 errors.Error.LogValue，
+// 包括 code、reason、reason_code、message、metadata、causes 和 stack，其他错误保持不变
+func NewErrorLogger(logger log.Logger) log.Logger {
+	return &errorLogger{logger}
+}
+
+func (e *errorLogger) Log(level log.Level, keyvals ...any) error {
+	var newKeyvals []any
+	for i := 1; i < len(keyvals); i += 2 {
+		err, ok := keyvals[i].(error)
+		if !ok {
+			continue
+		}
+		se, ok := errors.Find(err)
+		if !ok {
+			continue
+		}
+		// 只在有 *errors.Error 时复制，避免额外的内存分配
+		if newKeyvals == nil {
+			newKeyvals = make([]any, len(keyvals))
+			copy(newKeyvals, keyvals)
+		}
+		newKeyvals[i] = se.LogValue()
+	}
+	if newKeyvals == nil {
+		return e.logger.Log(level, keyvals...)
+	}
+	return e.logger.Log(level, newKeyvals...)
+}
@@ -4,14 +4,12 @@ import (
 	"os"
 
 	"github.com/go-kratos/kratos/v2/log"
-	"go.uber.org/zap"
-	"go.uber.org/zap/zapcore"
-	"gopkg.in/natefinch/lumberjack.v2"
 )
 
 type FileLoggerConfig struct {
 	Path     string
 	Rotating *RotatingFileLoggerConfig
+	Encoder  EncoderConfig
 }
 
 func NewFileLogger(config FileLoggerConfig) (log.Logger, func(), error) {
@@ -20,23 +18,22 @@ func NewFileLogger(config FileLoggerConfig) (log.Logger, func(), error) {
 	// 禁用文件轮换，则返回文件日志
 	if config.Rotating == nil {
 		var rc func()
-		logger, rc, err = newFileLogger(config.Path)
+		logger, rc, err = newFileLogger(config.Path, config.Encoder)
 		if err != nil {
 			return nil, nil, err
 		}
 		return logger, rc, nil
 	}
 
-	logger, rc := newRotatingFileLogger(config.Path, config.Rotating)
-	return logger, rc, nil
+	return newRotatingFileLogger(config.Path, config.Rotating, config.Encoder)
 }
 
-func newFileLogger(filename string) (log.Logger, func(), error) {
+func newFileLogger(filename string, encoder EncoderConfig) (log.Logger, func(), error) {
 	f, err := os.OpenFile(filename, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0666)
 	if err != nil {
 		return nil, nil, err
 	}
-	return log.NewStdLogger(f), func() {
+	return NewEncoderLogger(f, encoder), func() {
 		_ = f.Close()
 	}, nil
 }
@@ -44,37 +41,30 @@ func newFileLogger(filename string) (log.Logger, func(), error) {
 type RotatingFileLoggerConfig struct {
 	// 日志文件在轮转前允许的最大大小。默认为 100 MB。
 	MaxSize int
-	// 是根据备份文件名中编码的时间戳来保留旧日志文件的最大天数。
+	// 是根据备份文件的修改时间来保留旧日志文件的最大天数。
 	// 注意：一天被定义为 24 小时，可能与日历中的自然日不完全对应，
 	// 比如受到夏令时、闰秒等影响。默认不会因为时间而删除旧日志文件。
 	MaxFileAge int
 	// 要保留的旧日志文件的最大数量。
 	// 默认会保留所有旧日志文件（但 MaxAge 仍可能导致旧文件被删除）。
 	MaxFiles int
-	// 决定备份文件名中的时间戳是否使用本地时间。默认使用 UTC 时间。
+	// 决定备份文件名中的时间戳和按时间轮转的周期是否使用本地时间。默认使用 UTC 时间。
 	LocalTime bool
-	// 决定轮转后的日志文件是否使用 gzip 压缩。默认不压缩。
+	// 决定轮转后的日志文件是否使用 gzip 压缩（在后台协程中执行）。默认不压缩。
 	Compress bool
+	// 按时间轮转的周期，RotateIntervalNone、RotateIntervalHourly 或 RotateIntervalDaily。默认只按大小轮转。
+	Interval string
+	// 备份文件名，支持 %Y %m %d %H %M %S 时间占位符，不能包含目录。
+	// 默认为日志文件名加时间，例如 daily 时为 app-%Y-%m-%d.log。
+	Pattern string
 }
 
-func newRotatingFileLogger(filename string, config *RotatingFileLoggerConfig) (log.Logger, func()) {
-	return newRotatingFileLoggerWithZapEncoder(filename, config, zapcore.NewJSONEncoder(zap.NewProductionEncoderConfig()))
-}
-
-func newRotatingFileLoggerWithZapEncoder(filename string, config *RotatingFileLoggerConfig, encoder zapcore.Encoder) (log.Logger, func()) {
-	writeSyncer := zapcore.AddSync(&lumberjack.Logger{
-		Filename:   filename,
-		MaxSize:    config.MaxSize,
-		MaxAge:     config.MaxFileAge,
-		MaxBackups: config.MaxFiles,
-		LocalTime:  config.LocalTime,
-		Compress:   config.Compress,
-	})
-
-	core := zapcore.NewCore(encoder, writeSyncer, zapcore.DebugLevel) // 默认允许所有日志打印，由外部去控制 level
-	z := zap.New(core)
-
-	return NewZapLogger(z, log.DefaultMessageKey), func() {
-		_ = z.Sync()
+func newRotatingFileLogger(filename string, config *RotatingFileLoggerConfig, encoder EncoderConfig) (log.Logger, func(), error) {
+	w, err := newRotatingWriter(filename, *config)
+	if err != nil {
+		return nil, nil, err
 	}
+	return NewEncoderLogger(w, encoder), func() {
+		_ = w.Close()
+	}, nil
 }
@@ -6,14 +6,56 @@ import (
 	"github.com/go-kratos/kratos/v2/log"
 )
 
+// forceLevelKey 强制输出标记的 key，使用私有类型避免与业务 key 冲突
+type forceLevelKey struct{}
+
+// WithForceLevel 在 keyvals 末尾追加强制输出标记
+// 带有标记的日志会跳过 NewFilterLevelLogger 的级别过滤（用于按请求临时调低日志级别）
+func WithForceLevel(keyvals []any) []any {
+	return append(keyvals[:len(keyvals):len(keyvals)], forceLevelKey{}, true)
+}
+
+// takeForceLevel 取出 keyvals 末尾的强制输出标记
+func takeForceLevel(keyvals []any) ([]any, bool) {
+	n := len(keyvals)
+	if n < 2 {
+		return keyvals, false
+	}
+	if _, ok := keyvals[n-2].(forceLevelKey); !ok {
+		return keyvals, false
+	}
+	return keyvals[:n-2], true
+}
+
+// filterLevelLogger 按日志级别过滤的日志器
+type filterLevelLogger struct {
+	logger   log.Logger
+	level    log.Level
+	maxLevel log.Level
+	// force 带有强制输出标记的日志是否跳过 level 的过滤
+	force bool
+}
+
 // NewFilterLevelLogger 创建一个按日志级别过滤的日志器
-// 如果日志级别为 LevelDebug，则返回原日志器（不过滤）
-// 否则包装日志器，只输出不低于指定级别的日志
+// 只输出不低于指定级别的日志，带有强制输出标记（见 WithForceLevel）的日志不过滤，
+// 标记本身不会输出到底层日志器
 func NewFilterLevelLogger(logger log.Logger, level log.Level) log.Logger {
-	if level == log.LevelDebug {
-		return logger
+	return &filterLevelLogger{logger, level, log.LevelFatal, true}
+}
+
+// NewFilterLevelRangeLogger 创建一个只输出 [level, maxLevel] 范围内日志的日志器
+// force 为 true 时带有强制输出标记的日志只跳过 level 的过滤；
+// 按级别分流的输出目标（例如只写 error 以上的文件）传 false，强制输出的日志同样按 level 过滤
+func NewFilterLevelRangeLogger(logger log.Logger, level, maxLevel log.Level, force bool) log.Logger {
+	return &filterLevelLogger{logger, level, maxLevel, force}
+}
+
+func (f *filterLevelLogger) Log(level log.Level, keyvals ...any) error {
+	keyvals, force := takeForceLevel(keyvals)
+	if ((!force || !f.force) && level < f.level) || level > f.maxLevel {
+		return nil
 	}
-	return log.NewFilter(logger, log.FilterLevel(level))
+	return f.logger.Log(level, keyvals...)
 }
 
 // filterLogger 支持按键和空值过滤的日志器
@@ -0,0 +1,70 @@
+package internal_logger
+
+import (
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// recordLogger 记录收到的日志
+type recordLogger struct {
+	mu      sync.Mutex
+	records []record
+}
+
+type record struct {
+	level   log.Level
+	keyvals []any
+}
+
+func (l *recordLogger) Log(level log.Level, keyvals ...any) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.records = append(l.records, record{level, keyvals})
+	return nil
+}
+
+func (l *recordLogger) levels() []log.Level {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	levels := make([]log.Level, len(l.records))
+	for i, r := range l.records {
+		levels[i] = r.level
+	}
+	return levels
+}
+
+func TestFilterLevelLoggerForce(t *testing.T) {
+	all := []log.Level{log.LevelDebug, log.LevelInfo, log.LevelWarn, log.LevelError}
+	tests := []struct {
+		name   string
+		logger log.Logger
+		levels []log.Level
+	}{
+		{"level", NewFilterLevelLogger(nil, log.LevelWarn), all},
+		{"range force", NewFilterLevelRangeLogger(nil, log.LevelWarn, log.LevelWarn, true), all[:3]},
+		// 按级别分流的输出目标，强制输出的日志同样按 level 过滤
+		{"range strict", NewFilterLevelRangeLogger(nil, log.LevelError, log.LevelFatal, false), all[3:]},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := &recordLogger{}
+			logger := tt.logger.(*filterLevelLogger)
+			logger.logger = rec
+			for _, level := range all {
+				_ = logger.Log(level, WithForceLevel([]any{"msg", "forced"})...)
+			}
+			if got := rec.levels(); !slices.Equal(got, tt.levels) {
+				t.Fatalf("levels = %v, want %v", got, tt.levels)
+			}
+			// 标记本身不会输出
+			for _, r := range rec.records {
+				if len(r.keyvals) != 2 {
+					t.Errorf("keyvals = %v", r.keyvals)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,194 @@
+package internal_logger
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"go.opentelemetry.io/otel/attribute"
+	otellog "go.opentelemetry.io/otel/log"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+	"go.opentelemetry.io/otel/sdk/resource"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// otlpScopeName 日志的 instrumentation scope 名称
+const otlpScopeName = "github.com/jaggerzhuang1994/kratos-foundation/pkg/log"
+
+// OtlpLoggerConfig OTLP 日志配置
+type OtlpLoggerConfig struct {
+	Exporter           sdklog.Exporter      // 导出器
+	Resource           []attribute.KeyValue // resource 属性，例如服务名称、实例 ID、版本
+	TimeKey            string               // 时间戳的 key，值为 time.Time 时作为日志时间
+	TraceIDKey         string               // 追踪 ID 的 key，值作为日志的 trace id
+	SpanIDKey          string               // 跨度 ID 的 key，值作为日志的 span id
+	MaxQueueSize       int                  // 队列大小，不大于 0 时为 2048
+	ExportInterval     time.Duration        // 导出间隔，不大于 0 时为 1s
+	MaxExportBatchSize int                  // 每次导出的最大条数，不大于 0 时为 512
+}
+
+// otlpLogger 将日志转换为 OpenTelemetry 日志记录，由 BatchProcessor 批量导出
+type otlpLogger struct {
+	logger otellog.Logger
+	config OtlpLoggerConfig
+}
+
+// NewOtlpLogger 创建 OTLP 日志器
+// 返回的释放函数会导出队列中剩余的日志并关闭导出器
+func NewOtlpLogger(config OtlpLoggerConfig) (log.Logger, func()) {
+	provider := sdklog.NewLoggerProvider(
+		sdklog.WithResource(resource.NewSchemaless(config.Resource...)),
+		sdklog.WithProcessor(sdklog.NewBatchProcessor(config.Exporter,
+			sdklog.WithMaxQueueSize(config.MaxQueueSize),
+			sdklog.WithExportInterval(config.ExportInterval),
+			sdklog.WithExportMaxBatchSize(config.MaxExportBatchSize),
+		)),
+	)
+	return &otlpLogger{
+		logger: provider.Logger(otlpScopeName),
+		config: config,
+	}, func() {
+		_ = provider.Shutdown(context.Background())
+	}
+}
+
+func (o *otlpLogger) Log(level log.Level, keyvals ...any) error {
+	if len(keyvals) == 0 {
+		return nil
+	}
+	if (len(keyvals) & 1) == 1 {
+		keyvals = append(keyvals, "KEYVALS UNPAIRED")
+	}
+
+	var record otellog.Record
+	record.SetSeverity(otlpSeverity(level))
+	record.SetSeverityText(level.String())
+
+	var traceID trace.TraceID
+	var spanID trace.SpanID
+	attrs := make([]otellog.KeyValue, 0, len(keyvals)/2)
+	for i := 0; i < len(keyvals); i += 2 {
+		key := fmt.Sprint(keyvals[i])
+		val := keyvals[i+1]
+		switch key {
+		case log.DefaultMessageKey:
+			record.SetBody(otellog.StringValue(fmt.Sprint(val)))
+			continue
+		case o.config.TimeKey:
+			if t, ok := val.(time.Time); ok {
+				record.SetTimestamp(t)
+				continue
+			}
+		case o.config.TraceIDKey:
+			if id, err := trace.TraceIDFromHex(fmt.Sprint(val)); err == nil {
+				traceID = id
+				continue
+			}
+		case o.config.SpanIDKey:
+			if id, err := trace.SpanIDFromHex(fmt.Sprint(val)); err == nil {
+				spanID = id
+				continue
+			}
+		}
+		attrs = append(attrs, otellog.KeyValue{Key: key, Value: otlpValue(val)})
+	}
+	record.AddAttributes(attrs...)
+
+	// trace id 和 span id 通过 context 写入日志记录的对应字段
+	ctx := context.Background()
+	if traceID.IsValid() {
+		ctx = trace.ContextWithSpanContext(ctx, trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID: traceID,
+			SpanID:  spanID,
+		}))
+	}
+	o.logger.Emit(ctx, record)
+	return nil
+}
+
+// otlpSeverity 日志级别转换为 OpenTelemetry 日志级别
+func otlpSeverity(level log.Level) otellog.Severity {
+	switch level {
+	case log.LevelDebug:
+		return otellog.SeverityDebug
+	case log.LevelInfo:
+		return otellog.SeverityInfo
+	case log.LevelWarn:
+		return otellog.SeverityWarn
+	case log.LevelError:
+		return otellog.SeverityError
+	case log.LevelFatal:
+		return otellog.SeverityFatal
+	default:
+		return otellog.SeverityUndefined
+	}
+}
+
+// otlpValue 日志的 value 转换为 OpenTelemetry 日志属性值，map 和切片递归转换
+func otlpValue(v any) otellog.Value {
+	switch vt := v.(type) {
+	case nil:
+		return otellog.Value{}
+	case string:
+		return otellog.StringValue(vt)
+	case bool:
+		return otellog.BoolValue(vt)
+	case int:
+		return otellog.IntValue(vt)
+	case int8:
+		return otellog.Int64Value(int64(vt))
+	case int16:
+		return otellog.Int64Value(int64(vt))
+	case int32:
+		return otellog.Int64Value(int64(vt))
+	case int64:
+		return otellog.Int64Value(vt)
+	case uint8:
+		return otellog.Int64Value(int64(vt))
+	case uint16:
+		return otellog.Int64Value(int64(vt))
+	case uint32:
+		return otellog.Int64Value(int64(vt))
+	case float32:
+		return otellog.Float64Value(float64(vt))
+	case float64:
+		return otellog.Float64Value(vt)
+	case []byte:
+		return otellog.BytesValue(vt)
+	case time.Time:
+		return otellog.StringValue(vt.Format(time.RFC3339Nano))
+	case time.Duration:
+		return otellog.StringValue(vt.String())
+	case error:
+		return otellog.StringValue(vt.Error())
+	case fmt.Stringer:
+		return otellog.StringValue(vt.String())
+	case map[string]any:
+		kvs := make([]otellog.KeyValue, 0, len(vt))
+		for k, item := range vt {
+			kvs = append(kvs, otellog.KeyValue{Key: k, Value: otlpValue(item)})
+		}
+		return otellog.MapValue(kvs...)
+	case map[string]string:
+		kvs := make([]otellog.KeyValue, 0, len(vt))
+		for k, item := range vt {
+			kvs = append(kvs, otellog.String(k, item))
+		}
+		return otellog.MapValue(kvs...)
+	case []any:
+		values := make([]otellog.Value, len(vt))
+		for i, item := range vt {
+			values[i] = otlpValue(item)
+		}
+		return otellog.SliceValue(values...)
+	case []string:
+		values := make([]otellog.Value, len(vt))
+		for i, item := range vt {
+			values[i] = otellog.StringValue(item)
+		}
+		return otellog.SliceValue(values...)
+	default:
+		return otellog.StringValue(fmt.Sprint(v))
+	}
+}
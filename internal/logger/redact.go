@@ -0,0 +1,297 @@
+package internal_logger
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware/logging"
+	"github.com/jaggerzhuang1994/kratos-foundation/internal/secret"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// 掩码方式
+const (
+	MaskFull    = "full"    // 替换为 ******
+	MaskPartial = "partial" // 保留首尾各 1/4（最多 4 个字符）
+	MaskHash    = "hash"    // 替换为 HMAC-SHA256 摘要的前 16 位，密钥见 NewRedactLogger 的 hashKey
+)
+
+// RedactRule 脱敏规则
+type RedactRule struct {
+	Keys   []string // key 通配符，嵌套字段为以 . 分隔的路径
+	Values []string // value 正则
+	Mask   string   // 掩码方式，默认 MaskFull
+}
+
+// Args 日志中记录的请求参数
+// 输出与 kratos logging 中间件一致，脱敏时按原始结构（proto 消息转换为 json）处理
+type Args struct {
+	Value any
+}
+
+func (a Args) String() string {
+	if redacter, ok := a.Value.(logging.Redacter); ok {
+		return redacter.Redact()
+	}
+	if stringer, ok := a.Value.(fmt.Stringer); ok {
+		return stringer.String()
+	}
+	return fmt.Sprintf("%+v", a.Value)
+}
+
+type redactRule struct {
+	keys   []string
+	values []*regexp.Regexp
+	mask   func(string) string
+}
+
+// redactLogger 按规则对日志中的 value 脱敏
+type redactLogger struct {
+	logger log.Logger
+	rules  []redactRule
+}
+
+// NewRedactLogger 创建脱敏日志器
+// hashKey 为 MaskHash 使用的 HMAC 密钥，不加密钥的摘要可以对手机号、卡号等取值范围小的值暴力还原
+// 正则或掩码方式不合法、使用 MaskHash 但 hashKey 为空时返回错误；没有规则时返回原日志器
+func NewRedactLogger(logger log.Logger, rules []RedactRule, hashKey string) (log.Logger, error) {
+	if len(rules) == 0 {
+		return logger, nil
+	}
+	r := &redactLogger{logger: logger}
+	for i, rule := range rules {
+		if len(rule.Keys) == 0 && len(rule.Values) == 0 {
+			continue
+		}
+		var compiled redactRule
+		for _, key := range rule.Keys {
+			key = strings.ToLower(key)
+			if _, err := path.Match(key, ""); err != nil {
+				return nil, errors.WithMessagef(err, "invalid redact rule [%d] key %q", i, key)
+			}
+			compiled.keys = append(compiled.keys, key)
+		}
+		for _, value := range rule.Values {
+			re, err := regexp.Compile(value)
+			if err != nil {
+				return nil, errors.WithMessagef(err, "invalid redact rule [%d] value %q", i, value)
+			}
+			compiled.values = append(compiled.values, re)
+		}
+		switch rule.Mask {
+		case "", MaskFull:
+			compiled.mask = maskFull
+		case MaskPartial:
+			compiled.mask = maskPartial
+		case MaskHash:
+			if hashKey == "" {
+				return nil, errors.Errorf("invalid redact rule [%d] mask %q: hash key is required", i, rule.Mask)
+			}
+			compiled.mask = maskHash(hashKey)
+		default:
+			return nil, errors.Errorf("invalid redact rule [%d] mask %q", i, rule.Mask)
+		}
+		r.rules = append(r.rules, compiled)
+	}
+	if len(r.rules) == 0 {
+		return logger, nil
+	}
+	return r, nil
+}
+
+func (r *redactLogger) Log(level log.Level, keyvals ...any) error {
+	// 没有命中规则时直接输出原始 keyvals，避免每条日志都复制
+	var newKeyvals []any
+	for i := 0; i+1 < len(keyvals); i += 2 {
+		key, ok := keyvals[i].(string)
+		if !ok {
+			continue
+		}
+		redacted, changed := r.redact([]string{strings.ToLower(key)}, keyvals[i+1])
+		if !changed {
+			continue
+		}
+		if newKeyvals == nil {
+			newKeyvals = make([]any, len(keyvals))
+			copy(newKeyvals, keyvals)
+		}
+		newKeyvals[i+1] = redacted
+	}
+	if newKeyvals == nil {
+		return r.logger.Log(level, keyvals...)
+	}
+	return r.logger.Log(level, newKeyvals...)
+}
+
+// redact 按 key 路径对 value 脱敏，map、切片和 proto 消息递归处理，返回脱敏后的 value 以及是否发生变化
+// 没有变化时返回原始对象；proto 消息始终输出为 json 字符串
+func (r *redactLogger) redact(keyPath []string, v any) (any, bool) {
+	// 命中只配置了 key 的规则，替换整个 value
+	for _, rule := range r.rules {
+		if len(rule.values) == 0 && rule.matchKey(keyPath) {
+			return rule.mask(fmt.Sprint(v)), true
+		}
+	}
+
+	switch vt := v.(type) {
+	case string:
+		s := r.redactString(keyPath, vt)
+		return s, s != vt
+	case error:
+		if s := r.redactString(keyPath, vt.Error()); s != vt.Error() {
+			return errors.New(s), true
+		}
+		return v, false
+	case Args:
+		if m, ok := vt.Value.(proto.Message); ok {
+			if _, ok = vt.Value.(logging.Redacter); !ok {
+				return r.redactProto(keyPath, m), true
+			}
+		}
+		s := r.redactString(keyPath, vt.String())
+		if s == vt.String() {
+			return v, false
+		}
+		return s, true
+	case proto.Message:
+		return r.redactProto(keyPath, vt), true
+	case map[string]any:
+		return redactMap(r, keyPath, vt)
+	case map[string]string:
+		return redactMap(r, keyPath, vt)
+	case []any:
+		return redactSlice(r, keyPath, vt)
+	case []string:
+		return redactSlice(r, keyPath, vt)
+	case fmt.Stringer:
+		if s := r.redactString(keyPath, vt.String()); s != vt.String() {
+			return s, true
+		}
+		return v, false
+	default:
+		return v, false
+	}
+}
+
+// redactMap 有字段发生变化时返回 map[string]any 的副本
+func redactMap[V any](r *redactLogger, keyPath []string, vm map[string]V) (any, bool) {
+	var m map[string]any
+	for k, item := range vm {
+		redacted, changed := r.redact(appendPath(keyPath, k), item)
+		if !changed {
+			continue
+		}
+		if m == nil {
+			m = make(map[string]any, len(vm))
+			for k, item := range vm {
+				m[k] = item
+			}
+		}
+		m[k] = redacted
+	}
+	if m == nil {
+		return vm, false
+	}
+	return m, true
+}
+
+// redactSlice 有元素发生变化时返回 []any 的副本
+func redactSlice[V any](r *redactLogger, keyPath []string, vl []V) (any, bool) {
+	var l []any
+	for i, item := range vl {
+		redacted, changed := r.redact(keyPath, item)
+		if !changed {
+			continue
+		}
+		if l == nil {
+			l = make([]any, len(vl))
+			for i, item := range vl {
+				l[i] = item
+			}
+		}
+		l[i] = redacted
+	}
+	if l == nil {
+		return vl, false
+	}
+	return l, true
+}
+
+// redactString 替换命中 value 正则的部分
+func (r *redactLogger) redactString(keyPath []string, s string) string {
+	for _, rule := range r.rules {
+		if len(rule.values) == 0 || (len(rule.keys) > 0 && !rule.matchKey(keyPath)) {
+			continue
+		}
+		for _, re := range rule.values {
+			s = re.ReplaceAllStringFunc(s, rule.mask)
+		}
+	}
+	return s
+}
+
+// redactProto proto 消息按 json 结构（字段名为 proto 字段名）脱敏后输出为 json 字符串
+func (r *redactLogger) redactProto(keyPath []string, m proto.Message) any {
+	data, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(m)
+	if err != nil {
+		return r.redactString(keyPath, fmt.Sprint(m))
+	}
+	var v any
+	if err = json.Unmarshal(data, &v); err != nil {
+		return r.redactString(keyPath, string(data))
+	}
+	redacted, _ := r.redact(keyPath, v)
+	data, err = json.Marshal(redacted)
+	if err != nil {
+		return r.redactString(keyPath, fmt.Sprint(m))
+	}
+	return string(data)
+}
+
+// matchKey key 路径是否命中规则，规则为单段时匹配路径的最后一段
+func (rule *redactRule) matchKey(keyPath []string) bool {
+	full := strings.Join(keyPath, ".")
+	last := keyPath[len(keyPath)-1]
+	for _, pattern := range rule.keys {
+		if ok, _ := path.Match(pattern, full); ok {
+			return true
+		}
+		if !strings.Contains(pattern, ".") {
+			if ok, _ := path.Match(pattern, last); ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func appendPath(keyPath []string, key string) []string {
+	return append(keyPath[:len(keyPath):len(keyPath)], strings.ToLower(key))
+}
+
+func maskFull(string) string {
+	return secret.Mask
+}
+
+func maskPartial(s string) string {
+	runes := []rune(s)
+	keep := min(len(runes)/4, 4)
+	return string(runes[:keep]) + strings.Repeat("*", len(runes)-keep*2) + string(runes[len(runes)-keep:])
+}
+
+func maskHash(key string) func(string) string {
+	return func(s string) string {
+		mac := hmac.New(sha256.New, []byte(key))
+		mac.Write([]byte(s))
+		return "hmac:" + hex.EncodeToString(mac.Sum(nil))[:16]
+	}
+}
@@ -0,0 +1,141 @@
+package internal_logger
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func newTestRedactLogger(t *testing.T, rules ...RedactRule) (log.Logger, *recordLogger) {
+	t.Helper()
+	rec := &recordLogger{}
+	logger, err := NewRedactLogger(rec, rules, "test-key")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return logger, rec
+}
+
+// value 最后一条日志中 key 对应的值
+func (l *recordLogger) value(key string) any {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	keyvals := l.records[len(l.records)-1].keyvals
+	for i := 0; i+1 < len(keyvals); i += 2 {
+		if keyvals[i] == key {
+			return keyvals[i+1]
+		}
+	}
+	return nil
+}
+
+func TestRedactNestedMap(t *testing.T) {
+	logger, rec := newTestRedactLogger(t,
+		RedactRule{Keys: []string{"password", "*.user.token"}},
+		RedactRule{Keys: []string{"card"}, Mask: MaskHash},
+		RedactRule{Values: []string{`1\d{10}`}, Mask: MaskPartial},
+	)
+	_ = logger.Log(log.LevelInfo,
+		"payload", map[string]any{
+			"user": map[string]any{
+				"password": "p@ss",
+				"token":    "t0ken",
+				"name":     "bob",
+				"phones":   []any{"13812345678"},
+			},
+			// 多段规则匹配完整路径，payload.token 不命中 *.user.token
+			"token": "keep",
+			"Card":  "6222020000000000",
+		},
+		"msg", "call 13812345678",
+	)
+
+	want := map[string]any{
+		"user": map[string]any{
+			"password": "******",
+			"token":    "******",
+			"name":     "bob",
+			"phones":   []any{"13*******78"},
+		},
+		"token": "keep",
+		"Card":  maskHash("test-key")("6222020000000000"),
+	}
+	if got := rec.value("payload"); !reflect.DeepEqual(got, want) {
+		t.Errorf("payload = %v, want %v", got, want)
+	}
+	if got := rec.value("msg"); got != "call 13*******78" {
+		t.Errorf("msg = %v", got)
+	}
+}
+
+func TestRedactNoMatchKeepsOriginal(t *testing.T) {
+	logger, rec := newTestRedactLogger(t, RedactRule{Keys: []string{"password"}})
+	payload := map[string]any{"name": "bob"}
+	keyvals := []any{"msg", "hello", "payload", payload}
+	_ = logger.Log(log.LevelInfo, keyvals...)
+
+	// 没有命中规则时输出原始的 keyvals 和 value
+	got := rec.records[0].keyvals
+	if &got[0] != &keyvals[0] {
+		t.Error("keyvals copied without any match")
+	}
+	if reflect.ValueOf(got[3]).UnsafePointer() != reflect.ValueOf(payload).UnsafePointer() {
+		t.Error("payload copied without any match")
+	}
+}
+
+func TestRedactProtoArgs(t *testing.T) {
+	logger, rec := newTestRedactLogger(t, RedactRule{Keys: []string{"args.user.password"}})
+	req, err := structpb.NewStruct(map[string]any{
+		"user": map[string]any{"name": "bob", "password": "p@ss"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = logger.Log(log.LevelInfo, "args", Args{Value: req})
+
+	// proto 消息按 json 结构脱敏后输出为 json 字符串
+	s, ok := rec.value("args").(string)
+	if !ok {
+		t.Fatalf("args = %#v, want json string", rec.value("args"))
+	}
+	var got map[string]any
+	if err = json.Unmarshal([]byte(s), &got); err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]any{"user": map[string]any{"name": "bob", "password": "******"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("args = %v, want %v", got, want)
+	}
+}
+
+func TestRedactArgsString(t *testing.T) {
+	logger, rec := newTestRedactLogger(t, RedactRule{Keys: []string{"args"}, Values: []string{`secret=\w+`}})
+	_ = logger.Log(log.LevelInfo, "args", Args{Value: struct{ Q string }{"secret=abc"}}, "other", "secret=abc")
+
+	if got := rec.value("args"); got != "{Q:******}" {
+		t.Errorf("args = %v", got)
+	}
+	// 配置了 key 的 value 规则只作用于命中的 key
+	if got := rec.value("other"); got != "secret=abc" {
+		t.Errorf("other = %v", got)
+	}
+}
+
+func TestNewRedactLoggerInvalidRule(t *testing.T) {
+	for _, rule := range []RedactRule{
+		{Keys: []string{"[a"}},
+		{Values: []string{"("}},
+		{Keys: []string{"a"}, Mask: "unknown"},
+		// hash 需要密钥
+		{Keys: []string{"a"}, Mask: MaskHash},
+	} {
+		if _, err := NewRedactLogger(&recordLogger{}, []RedactRule{rule}, ""); err == nil || !strings.Contains(err.Error(), "invalid redact rule [0]") {
+			t.Errorf("rule %+v: err = %v", rule, err)
+		}
+	}
+}
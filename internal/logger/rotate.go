@@ -0,0 +1,402 @@
+package internal_logger
+
+import (
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// 按时间拆分的周期
+const (
+	RotateIntervalNone   = "none"   // 只按大小拆分
+	RotateIntervalHourly = "hourly" // 每小时拆分
+	RotateIntervalDaily  = "daily"  // 每天拆分
+)
+
+// rotatePatternToken 拆分后文件名的时间占位符，layout 用于生成文件名，regexp 用于查找拆分后的文件
+type rotatePatternToken struct {
+	layout string
+	regexp string
+}
+
+// rotatePatternTokens 拆分后文件名支持的时间占位符
+var rotatePatternTokens = map[byte]rotatePatternToken{
+	'Y': {"2006", `\d{4}`},
+	'm': {"01", `\d{2}`},
+	'd': {"02", `\d{2}`},
+	'H': {"15", `\d{2}`},
+	'M': {"04", `\d{2}`},
+	'S': {"05", `\d{2}`},
+}
+
+// rotatingWriter 按时间和大小拆分的文件
+//
+// 当前写入的文件始终为 filename，拆分时重命名为 pattern 对应的文件名（时间为文件所属周期的开始时间），
+// 同一周期内按大小拆分出的多个文件在扩展名前追加 .1、.2 等序号；
+// 清理过期文件和压缩在后台协程中执行，不阻塞写入
+type rotatingWriter struct {
+	filename string
+	config   RotatingFileLoggerConfig
+	maxSize  int64
+	location *time.Location
+
+	patternExt  string         // pattern 的扩展名，序号加在扩展名前
+	patternName *regexp.Regexp // 匹配拆分后的文件名（包括序号和 .gz）
+
+	mu          sync.Mutex
+	file        *os.File
+	size        int64
+	periodStart time.Time // 当前文件所属周期的开始时间
+	nextRotate  time.Time // 下一次按时间拆分的时间，按大小拆分时为零值
+	closed      bool
+
+	mill chan struct{}
+	done chan struct{}
+}
+
+// newRotatingWriter 创建按时间和大小拆分的文件，立即打开文件
+func newRotatingWriter(filename string, config RotatingFileLoggerConfig) (*rotatingWriter, error) {
+	if config.Interval == "" {
+		config.Interval = RotateIntervalNone
+	}
+	switch config.Interval {
+	case RotateIntervalNone, RotateIntervalHourly, RotateIntervalDaily:
+	default:
+		return nil, errors.Errorf("invalid rotating interval %q", config.Interval)
+	}
+	if config.Pattern == "" {
+		config.Pattern = defaultRotatePattern(filename, config.Interval)
+	}
+	if strings.ContainsRune(config.Pattern, '/') || strings.ContainsRune(config.Pattern, filepath.Separator) {
+		return nil, errors.Errorf("invalid rotating pattern %q: must be a file name", config.Pattern)
+	}
+	if config.MaxSize <= 0 {
+		config.MaxSize = 100
+	}
+
+	w := &rotatingWriter{
+		filename: filename,
+		config:   config,
+		maxSize:  int64(config.MaxSize) * 1024 * 1024,
+		location: time.UTC,
+		mill:     make(chan struct{}, 1),
+		done:     make(chan struct{}),
+	}
+	if config.LocalTime {
+		w.location = time.Local
+	}
+	w.patternExt = filepath.Ext(config.Pattern)
+	if strings.ContainsRune(w.patternExt, '%') {
+		w.patternExt = ""
+	}
+	w.patternName = rotatePatternRegexp(config.Pattern, w.patternExt)
+
+	if err := w.open(time.Now()); err != nil {
+		return nil, err
+	}
+	go w.run()
+	return w, nil
+}
+
+// defaultRotatePattern 默认的拆分后文件名，例如 app-2006-01-02.log
+func defaultRotatePattern(filename, interval string) string {
+	ext := filepath.Ext(filename)
+	name := strings.TrimSuffix(filepath.Base(filename), ext)
+	switch interval {
+	case RotateIntervalDaily:
+		return name + "-%Y-%m-%d" + ext
+	case RotateIntervalHourly:
+		return name + "-%Y-%m-%d-%H" + ext
+	default:
+		return name + "-%Y-%m-%dT%H-%M-%S" + ext
+	}
+}
+
+// rotatePatternRegexp 拆分后文件名的正则，扩展名前可以有序号，末尾可以有 .gz
+func rotatePatternRegexp(pattern, ext string) *regexp.Regexp {
+	prefix := strings.TrimSuffix(pattern, ext)
+	var b strings.Builder
+	b.WriteByte('^')
+	for i := 0; i < len(prefix); i++ {
+		if prefix[i] == '%' && i+1 < len(prefix) {
+			if token, ok := rotatePatternTokens[prefix[i+1]]; ok {
+				b.WriteString(token.regexp)
+				i++
+				continue
+			}
+		}
+		b.WriteString(regexp.QuoteMeta(prefix[i : i+1]))
+	}
+	b.WriteString(`(\.\d+)?`)
+	b.WriteString(regexp.QuoteMeta(ext))
+	b.WriteString(`(\.gz)?$`)
+	return regexp.MustCompile(b.String())
+}
+
+// expandRotatePattern 替换拆分后文件名中的时间占位符
+func expandRotatePattern(pattern string, t time.Time) string {
+	var b strings.Builder
+	for i := 0; i < len(pattern); i++ {
+		if pattern[i] == '%' && i+1 < len(pattern) {
+			if token, ok := rotatePatternTokens[pattern[i+1]]; ok {
+				b.WriteString(t.Format(token.layout))
+				i++
+				continue
+			}
+		}
+		b.WriteByte(pattern[i])
+	}
+	return b.String()
+}
+
+func (w *rotatingWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	now := time.Now()
+	if w.file == nil {
+		if err := w.open(now); err != nil {
+			return 0, err
+		}
+	}
+	if (!w.nextRotate.IsZero() && !now.Before(w.nextRotate)) || (w.size > 0 && w.size+int64(len(p)) > w.maxSize) {
+		if err := w.rotate(now); err != nil {
+			return 0, err
+		}
+	}
+	n, err := w.file.Write(p)
+	w.size += int64(n)
+	return n, err
+}
+
+// Close 关闭文件，等待后台的清理和压缩完成
+func (w *rotatingWriter) Close() error {
+	w.mu.Lock()
+	if !w.closed {
+		w.closed = true
+		close(w.mill)
+	}
+	w.mu.Unlock()
+	<-w.done
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.file == nil {
+		return nil
+	}
+	err := w.file.Close()
+	w.file = nil
+	return err
+}
+
+// open 打开当前文件，文件的修改时间早于当前周期时（例如跨天重启）先拆分
+func (w *rotatingWriter) open(now time.Time) error {
+	if err := os.MkdirAll(filepath.Dir(w.filename), 0755); err != nil {
+		return err
+	}
+	w.setPeriod(now)
+	if info, err := os.Stat(w.filename); err == nil && info.Size() > 0 && w.config.Interval != RotateIntervalNone &&
+		info.ModTime().Before(w.periodStart) {
+		if err = w.rename(w.truncate(info.ModTime())); err != nil {
+			return err
+		}
+	}
+
+	f, err := os.OpenFile(w.filename, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0666)
+	if err != nil {
+		return err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		_ = f.Close()
+		return err
+	}
+	w.file = f
+	w.size = info.Size()
+	return nil
+}
+
+// rotate 拆分当前文件，并通知后台清理和压缩
+func (w *rotatingWriter) rotate(now time.Time) error {
+	if err := w.file.Close(); err != nil {
+		return err
+	}
+	w.file = nil
+	name := w.periodStart
+	if w.config.Interval == RotateIntervalNone {
+		name = now.In(w.location)
+	}
+	if err := w.rename(name); err != nil {
+		return err
+	}
+	if err := w.open(now); err != nil {
+		return err
+	}
+	if w.closed {
+		return nil
+	}
+	select {
+	case w.mill <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// rename 将当前文件重命名为 t 对应的文件名，文件名已存在时追加序号
+func (w *rotatingWriter) rename(t time.Time) error {
+	dir := filepath.Dir(w.filename)
+	name := expandRotatePattern(w.config.Pattern, t)
+	prefix := strings.TrimSuffix(name, w.patternExt)
+	backup := filepath.Join(dir, name)
+	for i := 1; ; i++ {
+		_, errFile := os.Stat(backup)
+		_, errGz := os.Stat(backup + ".gz")
+		if os.IsNotExist(errFile) && os.IsNotExist(errGz) {
+			break
+		}
+		backup = filepath.Join(dir, prefix+"."+strconv.Itoa(i)+w.patternExt)
+	}
+	if err := os.Rename(w.filename, backup); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// setPeriod 设置当前周期的开始时间和下一次拆分时间
+func (w *rotatingWriter) setPeriod(now time.Time) {
+	if w.config.Interval == RotateIntervalNone {
+		return
+	}
+	w.periodStart = w.truncate(now)
+	if w.config.Interval == RotateIntervalHourly {
+		w.nextRotate = w.periodStart.Add(time.Hour)
+	} else {
+		w.nextRotate = w.periodStart.AddDate(0, 0, 1)
+	}
+}
+
+// truncate t 所属周期的开始时间
+func (w *rotatingWriter) truncate(t time.Time) time.Time {
+	t = t.In(w.location)
+	if w.config.Interval == RotateIntervalHourly {
+		return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), 0, 0, 0, w.location)
+	}
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, w.location)
+}
+
+// run 后台清理过期文件和压缩，启动时执行一次
+func (w *rotatingWriter) run() {
+	defer close(w.done)
+	w.millRun()
+	for range w.mill {
+		w.millRun()
+	}
+}
+
+type rotatedFile struct {
+	path    string
+	modTime time.Time
+}
+
+// millRun 按数量和天数清理拆分后的文件，再压缩未压缩的文件
+func (w *rotatingWriter) millRun() {
+	files := w.rotatedFiles()
+	// 按修改时间从新到旧排列
+	slices.SortFunc(files, func(a, b rotatedFile) int {
+		return b.modTime.Compare(a.modTime)
+	})
+
+	var remaining []rotatedFile
+	cutoff := time.Now().Add(-time.Duration(w.config.MaxFileAge) * 24 * time.Hour)
+	for i, f := range files {
+		if (w.config.MaxFiles > 0 && i >= w.config.MaxFiles) || (w.config.MaxFileAge > 0 && f.modTime.Before(cutoff)) {
+			_ = os.Remove(f.path)
+			continue
+		}
+		remaining = append(remaining, f)
+	}
+
+	if !w.config.Compress {
+		return
+	}
+	for _, f := range remaining {
+		if !strings.HasSuffix(f.path, ".gz") {
+			_ = compressFile(f.path)
+		}
+	}
+}
+
+// rotatedFiles 拆分后的文件
+func (w *rotatingWriter) rotatedFiles() []rotatedFile {
+	dir := filepath.Dir(w.filename)
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil
+	}
+	var files []rotatedFile
+	for _, entry := range entries {
+		if entry.IsDir() || entry.Name() == filepath.Base(w.filename) || !w.patternName.MatchString(entry.Name()) {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			continue
+		}
+		files = append(files, rotatedFile{path: filepath.Join(dir, entry.Name()), modTime: info.ModTime()})
+	}
+	return files
+}
+
+// compressFile 压缩为 .gz 文件并删除原文件，保留原文件的修改时间
+func compressFile(path string) (err error) {
+	src, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = src.Close()
+	}()
+	info, err := src.Stat()
+	if err != nil {
+		return err
+	}
+
+	tmp := path + ".gz.tmp"
+	dst, err := os.OpenFile(tmp, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, info.Mode())
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_ = os.Remove(tmp)
+		}
+	}()
+	gz := gzip.NewWriter(dst)
+	if _, err = io.Copy(gz, src); err != nil {
+		_ = dst.Close()
+		return err
+	}
+	if err = gz.Close(); err != nil {
+		_ = dst.Close()
+		return err
+	}
+	if err = dst.Close(); err != nil {
+		return err
+	}
+	if err = os.Chtimes(tmp, info.ModTime(), info.ModTime()); err != nil {
+		return err
+	}
+	if err = os.Rename(tmp, path+".gz"); err != nil {
+		return err
+	}
+	return os.Remove(path)
+}
@@ -0,0 +1,66 @@
+package internal_logger
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRotatingWriter(t *testing.T) {
+	dir := t.TempDir()
+	filename := filepath.Join(dir, "app.log")
+
+	// 上一次启动时写入的文件，修改时间早于当前周期
+	yesterday := time.Now().UTC().AddDate(0, 0, -1)
+	if err := os.WriteFile(filename, []byte("old\n"), 0666); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chtimes(filename, yesterday, yesterday); err != nil {
+		t.Fatal(err)
+	}
+
+	w, err := newRotatingWriter(filename, RotatingFileLoggerConfig{
+		MaxSize:  1,
+		Interval: RotateIntervalDaily,
+		Compress: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 超过 max_size 时同一周期内按大小拆分
+	line := []byte(strings.Repeat("x", 1023) + "\n")
+	for i := 0; i < 1025; i++ {
+		if _, err = w.Write(line); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err = w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	today := time.Now().UTC().Format("2006-01-02")
+	for _, name := range []string{
+		"app-" + yesterday.Format("2006-01-02") + ".log.gz",
+		"app-" + today + ".log.gz",
+		"app.log",
+	} {
+		if _, err = os.Stat(filepath.Join(dir, name)); err != nil {
+			t.Errorf("%s: %v", name, err)
+		}
+	}
+}
+
+func TestRotatingWriterConfig(t *testing.T) {
+	dir := t.TempDir()
+	if _, err := newRotatingWriter(filepath.Join(dir, "app.log"), RotatingFileLoggerConfig{Interval: "weekly"}); err == nil {
+		t.Error("invalid interval should return an error")
+	}
+	if _, err := newRotatingWriter(filepath.Join(dir, "app.log"), RotatingFileLoggerConfig{Pattern: "logs/app-%Y.log"}); err == nil {
+		t.Error("pattern with directory should return an error")
+	}
+	if got := expandRotatePattern("app-%Y%m%d-%H.log", time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)); got != "app-20250102-03.log" {
+		t.Errorf("expandRotatePattern = %s", got)
+	}
+}
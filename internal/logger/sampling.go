@@ -0,0 +1,166 @@
+package internal_logger
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// SamplingLoggerConfig 日志采样配置
+type SamplingLoggerConfig struct {
+	Interval        time.Duration // 统计周期，不大于 0 时为 1s
+	First           int           // 每个周期内相同日志先输出的条数
+	Thereafter      int           // 超过 First 后每 Thereafter 条输出 1 条，0 表示全部丢弃
+	SummaryInterval time.Duration // 输出被抑制日志汇总的周期，不大于 0 时与 Interval 相同
+	Keys            []string      // 判断日志是否相同的 key（和日志级别一起）
+	SummaryKv       []any         // 汇总日志携带的预设 kv
+}
+
+// samplingCounter 一类相同日志的计数
+type samplingCounter struct {
+	level      log.Level
+	keyvals    []any     // 判断日志是否相同的 kv，用于输出汇总
+	start      time.Time // 当前统计周期的开始时间
+	count      int       // 当前统计周期内的日志条数
+	suppressed int64     // 上次汇总后被抑制的日志条数
+}
+
+// samplingLogger 日志采样，相同的日志（级别和 Keys 的值都相同）在每个统计周期内
+// 先输出 First 条，之后每 Thereafter 条输出 1 条，被抑制的条数定期汇总输出
+type samplingLogger struct {
+	logger  log.Logger
+	summary log.Logger
+	config  SamplingLoggerConfig
+
+	mu       sync.Mutex
+	counters map[string]*samplingCounter
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+// NewSamplingLogger 创建日志采样日志器
+// 返回的释放函数会停止汇总协程，并输出最后一次汇总
+func NewSamplingLogger(logger log.Logger, config SamplingLoggerConfig) (log.Logger, func()) {
+	if config.Interval <= 0 {
+		config.Interval = time.Second
+	}
+	if config.SummaryInterval <= 0 {
+		config.SummaryInterval = config.Interval
+	}
+	s := &samplingLogger{
+		logger:   logger,
+		summary:  log.With(logger, config.SummaryKv...),
+		config:   config,
+		counters: map[string]*samplingCounter{},
+		stop:     make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+	go s.run()
+	return s, func() {
+		close(s.stop)
+		<-s.done
+	}
+}
+
+func (s *samplingLogger) Log(level log.Level, keyvals ...any) error {
+	// fatal 日志不采样
+	if level >= log.LevelFatal || s.allow(level, keyvals) {
+		return s.logger.Log(level, keyvals...)
+	}
+	return nil
+}
+
+// allow 判断日志是否输出
+func (s *samplingLogger) allow(level log.Level, keyvals []any) bool {
+	values := s.sampleKeyvals(keyvals)
+	key := level.String() + "|" + fmt.Sprint(values...)
+	now := time.Now()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	c, ok := s.counters[key]
+	if !ok {
+		c = &samplingCounter{level: level, keyvals: values, start: now}
+		s.counters[key] = c
+	}
+	if now.Sub(c.start) >= s.config.Interval {
+		c.start = now
+		c.count = 0
+	}
+	c.count++
+	if c.count <= s.config.First {
+		return true
+	}
+	if s.config.Thereafter > 0 && (c.count-s.config.First)%s.config.Thereafter == 0 {
+		return true
+	}
+	c.suppressed++
+	return false
+}
+
+// sampleKeyvals 取出判断日志是否相同的 kv
+func (s *samplingLogger) sampleKeyvals(keyvals []any) []any {
+	values := make([]any, 0, len(s.config.Keys)*2)
+	for _, key := range s.config.Keys {
+		for i := 0; i+1 < len(keyvals); i += 2 {
+			if k, ok := keyvals[i].(string); ok && k == key {
+				values = append(values, key, keyvals[i+1])
+				break
+			}
+		}
+	}
+	return values
+}
+
+func (s *samplingLogger) run() {
+	defer close(s.done)
+	ticker := time.NewTicker(s.config.SummaryInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			s.flush()
+		case <-s.stop:
+			s.flush()
+			return
+		}
+	}
+}
+
+// flush 输出被抑制日志的汇总，并清理已经过期的计数
+func (s *samplingLogger) flush() {
+	now := time.Now()
+	var summaries []*samplingCounter
+	s.mu.Lock()
+	for key, c := range s.counters {
+		if c.suppressed > 0 {
+			summary := *c
+			summaries = append(summaries, &summary)
+			c.suppressed = 0
+		}
+		if now.Sub(c.start) >= s.config.Interval {
+			delete(s.counters, key)
+		}
+	}
+	s.mu.Unlock()
+
+	for _, c := range summaries {
+		kv := make([]any, 0, len(c.keyvals)+4)
+		for i := 0; i+1 < len(c.keyvals); i += 2 {
+			key := c.keyvals[i].(string)
+			// 原日志的 msg 放到 sampled.msg，避免与汇总的 msg 冲突
+			if key == log.DefaultMessageKey {
+				key = "sampled." + key
+			}
+			kv = append(kv, key, c.keyvals[i+1])
+		}
+		kv = append(kv,
+			log.DefaultMessageKey, fmt.Sprintf("suppressed %d messages", c.suppressed),
+			"suppressed", c.suppressed,
+		)
+		_ = s.summary.Log(c.level, kv...)
+	}
+}
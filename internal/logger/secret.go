@@ -0,0 +1,32 @@
+package internal_logger
+
+import (
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/jaggerzhuang1994/kratos-foundation/internal/secret"
+)
+
+// secretLogger 对日志中出现的敏感值脱敏
+type secretLogger struct {
+	logger log.Logger
+}
+
+// NewSecretLogger 创建敏感值脱敏日志器
+// 日志中所有 value 里出现的敏感值（见 internal/secret）都会被替换为掩码
+func NewSecretLogger(logger log.Logger) log.Logger {
+	return &secretLogger{logger}
+}
+
+func (s *secretLogger) Log(level log.Level, keyvals ...any) error {
+	// 没有敏感值时直接输出，避免额外的内存分配
+	if secret.Len() == 0 {
+		return s.logger.Log(level, keyvals...)
+	}
+	newKeyvals := make([]any, len(keyvals))
+	for i, v := range keyvals {
+		if i%2 == 1 {
+			v = secret.RedactValue(v)
+		}
+		newKeyvals[i] = v
+	}
+	return s.logger.Log(level, newKeyvals...)
+}
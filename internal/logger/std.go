@@ -11,10 +11,10 @@ type stdLogger struct {
 	stderr log.Logger
 }
 
-func NewStdLogger() log.Logger {
+func NewStdLogger(encoder EncoderConfig) log.Logger {
 	return &stdLogger{
-		log.NewStdLogger(os.Stdout),
-		log.NewStdLogger(os.Stderr),
+		NewEncoderLogger(os.Stdout, encoder),
+		NewEncoderLogger(os.Stderr, encoder),
 	}
 }
 
@@ -0,0 +1,38 @@
+package dynamic
+
+import (
+	"context"
+	"sync/atomic"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+)
+
+// Middleware 可以在运行时替换的中间件
+//
+// kratos 的 server/client 每次请求都会重新组装中间件链，
+// 因此替换后的中间件对后续请求立即生效。nil 表示直接透传。
+type Middleware struct {
+	current atomic.Pointer[middleware.Middleware]
+}
+
+func New(m middleware.Middleware) *Middleware {
+	d := &Middleware{}
+	d.Update(m)
+	return d
+}
+
+func (d *Middleware) Update(m middleware.Middleware) {
+	d.current.Store(&m)
+}
+
+func (d *Middleware) Middleware() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req any) (any, error) {
+			m := *d.current.Load()
+			if m == nil {
+				return handler(ctx, req)
+			}
+			return m(handler)(ctx, req)
+		}
+	}
+}
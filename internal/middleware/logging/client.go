@@ -0,0 +1,70 @@
+package logging
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/go-kratos/kratos/v2/transport/http/status"
+	internal_logger "github.com/jaggerzhuang1994/kratos-foundation/internal/logger"
+	"github.com/jaggerzhuang1994/kratos-foundation/pkg/errors"
+	"google.golang.org/grpc/codes"
+)
+
+// client is a client logging middleware.
+// 与 kratos logging.Client 一致，args 保留原始请求，便于日志脱敏按结构处理
+func client(logger log.Logger) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req any) (reply any, err error) {
+			var (
+				code      int32
+				reason    string
+				kind      string
+				operation string
+			)
+
+			// default code
+			code = int32(status.FromGRPCCode(codes.OK))
+
+			startTime := time.Now()
+			if info, ok := transport.FromClientContext(ctx); ok {
+				kind = info.Kind().String()
+				operation = info.Operation()
+			}
+			reply, err = handler(ctx, req)
+			if se := errors.FromError(err); se != nil {
+				code = se.Code
+				reason = se.Reason
+			}
+			level, errKeyvals := extractError(err)
+			keyvals := []any{
+				"kind", "client",
+				"component", kind,
+				"operation", operation,
+				"args", internal_logger.Args{Value: req},
+				"code", code,
+				"reason", reason,
+			}
+			keyvals = append(keyvals, errKeyvals...)
+			keyvals = append(keyvals, "latency", time.Since(startTime).Seconds())
+			log.NewHelper(log.WithContext(ctx, logger)).Log(level, keyvals...)
+			return
+		}
+	}
+}
+
+// extractError 返回日志级别和错误字段
+// *errors.Error 输出 reason_code、message、metadata、causes 和 stack 帧数组，其他错误的 stack 为 %+v 格式化的字符串
+func extractError(err error) (log.Level, []any) {
+	if err == nil {
+		return log.LevelInfo, []any{"stack", ""}
+	}
+	if se, ok := errors.Find(err); ok {
+		// 跳过 LogKeyvals 开头的 code 和 reason，已经在外层输出
+		return log.LevelError, se.LogKeyvals()[4:]
+	}
+	return log.LevelError, []any{"stack", fmt.Sprintf("%+v", err)}
+}
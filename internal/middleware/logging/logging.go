@@ -4,7 +4,6 @@ import (
 	"context"
 
 	"github.com/go-kratos/kratos/v2/middleware"
-	"github.com/go-kratos/kratos/v2/middleware/logging"
 	"github.com/go-kratos/kratos/v2/transport/http"
 	"github.com/gorilla/websocket"
 	"github.com/jaggerzhuang1994/kratos-foundation/pkg/log"
@@ -18,7 +17,7 @@ func Server(log log.Log, config Config) middleware.Middleware {
 		return nil
 	}
 
-	m := logging.Server(log)
+	m := server(log)
 
 	return func(handler middleware.Handler) middleware.Handler {
 		logHandler := m(handler)
@@ -37,5 +36,5 @@ func Client(log log.Log, config Config) middleware.Middleware {
 	if config.GetDisable() {
 		return nil
 	}
-	return logging.Client(log)
+	return client(log)
 }
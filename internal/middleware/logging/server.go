@@ -0,0 +1,56 @@
+package logging
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/go-kratos/kratos/v2/transport/http/status"
+	internal_logger "github.com/jaggerzhuang1994/kratos-foundation/internal/logger"
+	"github.com/jaggerzhuang1994/kratos-foundation/pkg/errors"
+	"google.golang.org/grpc/codes"
+)
+
+// server is an server logging middleware.
+// 与 kratos logging.Server 一致，args 保留原始请求，便于日志脱敏按结构处理
+func server(logger log.Logger) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req any) (reply any, err error) {
+			var (
+				code      int32
+				reason    string
+				kind      string
+				operation string
+			)
+
+			// default code
+			code = int32(status.FromGRPCCode(codes.OK))
+
+			startTime := time.Now()
+			if info, ok := transport.FromServerContext(ctx); ok {
+				kind = info.Kind().String()
+				operation = info.Operation()
+			}
+			reply, err = handler(ctx, req)
+			if se := errors.FromError(err); se != nil {
+				code = se.Code
+				reason = se.Reason
+			}
+			level, errKeyvals := extractError(err)
+			keyvals := []any{
+				"kind", "server",
+				"component", kind,
+				"operation", operation,
+				"args", internal_logger.Args{Value: req},
+				"code", code,
+				"reason", reason,
+			}
+			keyvals = append(keyvals, errKeyvals...)
+			keyvals = append(keyvals, "latency", time.Since(startTime).Seconds())
+			log.NewHelper(log.WithContext(ctx, logger)).Log(level, keyvals...)
+			return
+		}
+	}
+}
@@ -40,6 +40,12 @@ func newMiddlewareOptions(configs ...Config) []Option {
 	if len(constants) > 0 {
 		opts = append(opts, withConstants(constants))
 	}
+	// 按请求调整日志级别，后面的 Config 会覆盖前面的
+	for _, config := range configs {
+		if logLevel := config.GetLogLevel(); logLevel != nil {
+			opts = append(opts, withRequestLevel(logLevel.GetTokens(), logLevel.GetSecret()))
+		}
+	}
 	return opts
 }
 
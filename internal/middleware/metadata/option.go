@@ -1,9 +1,12 @@
 package metadata
 
 import (
+	"crypto/subtle"
 	"strings"
 
+	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/metadata"
+	log2 "github.com/jaggerzhuang1994/kratos-foundation/pkg/log"
 )
 
 // Option is metadata option.
@@ -12,6 +15,10 @@ type Option func(*options)
 type options struct {
 	prefix []string
 	md     metadata.Metadata
+
+	// 按请求调整日志级别的白名单 token 和签名密钥
+	levelTokens []string
+	levelSecret string
 }
 
 func (o *options) hasPrefix(key string) bool {
@@ -37,3 +44,46 @@ func withPropagatedPrefix(prefix ...string) Option {
 		o.prefix = prefix
 	}
 }
+
+// withRequestLevel with request log level allowlist tokens and sign secret.
+func withRequestLevel(tokens []string, secret string) Option {
+	return func(o *options) {
+		o.levelTokens = tokens
+		o.levelSecret = secret
+	}
+}
+
+// requestLevel 获取请求日志级别
+// 未配置 token 和密钥时不生效；校验不通过时从 md 中移除相关的 key，不再传递给下游
+func (o *options) requestLevel(md metadata.Metadata) (log.Level, bool) {
+	if len(o.levelTokens) == 0 && o.levelSecret == "" {
+		return 0, false
+	}
+	value := md.Get(log2.RequestLevelKey)
+	if value == "" {
+		return 0, false
+	}
+	level, ok := log2.ParseRequestLevel(value)
+	if ok && o.allowRequestLevel(md, value) {
+		return level, true
+	}
+	delete(md, log2.RequestLevelKey)
+	delete(md, log2.RequestLevelTokenKey)
+	delete(md, log2.RequestLevelSignKey)
+	return 0, false
+}
+
+// allowRequestLevel token 命中白名单或签名校验通过
+func (o *options) allowRequestLevel(md metadata.Metadata, level string) bool {
+	if token := md.Get(log2.RequestLevelTokenKey); token != "" {
+		for _, t := range o.levelTokens {
+			if subtle.ConstantTimeCompare([]byte(t), []byte(token)) == 1 {
+				return true
+			}
+		}
+	}
+	if sign := md.Get(log2.RequestLevelSignKey); sign != "" && o.levelSecret != "" {
+		return log2.VerifyRequestLevel(o.levelSecret, level, sign)
+	}
+	return false
+}
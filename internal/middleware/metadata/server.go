@@ -9,6 +9,7 @@ import (
 	"github.com/go-kratos/kratos/v2/transport"
 	"github.com/go-kratos/kratos/v2/transport/http"
 	"github.com/gorilla/websocket"
+	"github.com/jaggerzhuang1994/kratos-foundation/pkg/log"
 )
 
 func server(opts ...Option) middleware.Middleware {
@@ -63,6 +64,10 @@ func server(opts ...Option) middleware.Middleware {
 				}
 			}
 
+			// 按请求调整日志级别，需要在写入 server ctx 前校验，校验不通过的不传递给下游
+			if level, ok := opt.requestLevel(md); ok {
+				ctx = log.NewLevelContext(ctx, level)
+			}
 			ctx = metadata.NewServerContext(ctx, md)
 			return handler(ctx, req)
 		}
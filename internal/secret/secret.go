@@ -0,0 +1,170 @@
+// Package secret 记录运行期间出现的敏感值（例如配置中通过占位符解析出来的密码）
+//
+// 日志、配置导出等输出在写出前通过 Redact 将这些值替换为掩码，
+// 保证敏感值不会以明文出现在任何输出中。
+package secret
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// Mask 敏感值的掩码
+const Mask = "******"
+
+// MinLength 参与子串替换的最短长度，过短的值（例如 "1"）替换后会破坏正常的日志内容
+// 更短的敏感值同样会记录，只在整个值与敏感值相同时替换（例如日志字段 password=abc）
+const MinLength = 4
+
+var (
+	mu     sync.RWMutex
+	values = map[string]struct{}{}
+	// count 敏感值数量，用于没有敏感值时快速跳过
+	count atomic.Int32
+)
+
+// Add 记录敏感值，空字符串忽略
+func Add(value string) {
+	if value == "" {
+		return
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if _, ok := values[value]; ok {
+		return
+	}
+	values[value] = struct{}{}
+	count.Store(int32(len(values)))
+}
+
+// Len 已记录的敏感值数量
+func Len() int {
+	return int(count.Load())
+}
+
+// Redact 将字符串中出现的敏感值替换为掩码
+// 短于 MinLength 的敏感值只在整个字符串与其相同时替换
+func Redact(s string) string {
+	if Len() == 0 || s == "" {
+		return s
+	}
+	mu.RLock()
+	defer mu.RUnlock()
+	if _, ok := values[s]; ok {
+		return Mask
+	}
+	if len(s) < MinLength {
+		return s
+	}
+	for v := range values {
+		if len(v) >= MinLength && strings.Contains(s, v) {
+			s = strings.ReplaceAll(s, v, Mask)
+		}
+	}
+	return s
+}
+
+// RedactValue 对日志 value 脱敏
+//
+// 只处理 string、error、fmt.Stringer，以及 map[string]any、map[string]string、[]any、[]string
+// （例如展开后的结构化错误，递归处理），其他类型原样返回；
+// 不包含敏感值时返回原始对象，不改变其类型
+func RedactValue(v any) any {
+	if Len() == 0 {
+		return v
+	}
+	redacted, _ := redactValue(v)
+	return redacted
+}
+
+// redactValue 返回脱敏后的 value 以及是否包含敏感值
+func redactValue(v any) (any, bool) {
+	var s string
+	switch vt := v.(type) {
+	case string:
+		s = vt
+	case error:
+		s = vt.Error()
+	case fmt.Stringer:
+		s = vt.String()
+	case map[string]any:
+		var m map[string]any
+		for k, item := range vt {
+			redacted, ok := redactValue(item)
+			if !ok {
+				continue
+			}
+			if m == nil {
+				m = maps.Clone(vt)
+			}
+			m[k] = redacted
+		}
+		if m == nil {
+			return v, false
+		}
+		return m, true
+	case map[string]string:
+		var m map[string]string
+		for k, item := range vt {
+			redacted := Redact(item)
+			if redacted == item {
+				continue
+			}
+			if m == nil {
+				m = maps.Clone(vt)
+			}
+			m[k] = redacted
+		}
+		if m == nil {
+			return v, false
+		}
+		return m, true
+	case []any:
+		var l []any
+		for i, item := range vt {
+			redacted, ok := redactValue(item)
+			if !ok {
+				continue
+			}
+			if l == nil {
+				l = slices.Clone(vt)
+			}
+			l[i] = redacted
+		}
+		if l == nil {
+			return v, false
+		}
+		return l, true
+	case []string:
+		var l []string
+		for i, item := range vt {
+			redacted := Redact(item)
+			if redacted == item {
+				continue
+			}
+			if l == nil {
+				l = slices.Clone(vt)
+			}
+			l[i] = redacted
+		}
+		if l == nil {
+			return v, false
+		}
+		return l, true
+	default:
+		return v, false
+	}
+	redacted := Redact(s)
+	if redacted == s {
+		return v, false
+	}
+	if _, ok := v.(error); ok {
+		return errors.New(redacted), true
+	}
+	return redacted, true
+}
@@ -0,0 +1,23 @@
+package secret
+
+import "testing"
+
+func TestRedactShortSecret(t *testing.T) {
+	Add("abc")
+	Add("p@ssw0rd")
+
+	tests := map[string]string{
+		"abc":               Mask,
+		"abcdef":            "abcdef",
+		"dsn user:p@ssw0rd": "dsn user:" + Mask,
+		"":                  "",
+	}
+	for s, want := range tests {
+		if got := Redact(s); got != want {
+			t.Errorf("Redact(%q) = %q, want %q", s, got, want)
+		}
+	}
+	if got := RedactValue(map[string]any{"password": "abc"}); got.(map[string]any)["password"] != Mask {
+		t.Errorf("RedactValue = %v", got)
+	}
+}
@@ -0,0 +1,89 @@
+// Package admin 提供管理接口的路由注册
+//
+// 各模块（配置导出等）向 Admin 注册管理接口，
+// 再由 HTTP 服务器统一挂载到 server.http.admin.path（默认 /admin）下。
+//
+// 管理接口默认关闭，开启后建议配置 token 或只在内网端口暴露。
+package admin
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// Admin 管理接口注册中心
+type Admin interface {
+	http.Handler
+	// Handle 注册管理接口，path 为相对于管理接口前缀的路径，例如 /config
+	Handle(path string, handler http.Handler)
+	// HandleFunc 注册管理接口
+	HandleFunc(path string, handler http.HandlerFunc)
+}
+
+type admin struct {
+	mux *http.ServeMux
+
+	mu    sync.RWMutex
+	paths []string
+}
+
+func NewAdmin() Admin {
+	a := &admin{mux: http.NewServeMux()}
+	// 根路径返回已注册的管理接口列表
+	a.mux.HandleFunc("/{$}", a.index)
+	return a
+}
+
+func (a *admin) Handle(path string, handler http.Handler) {
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+	a.mux.Handle(path, handler)
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.paths = append(a.paths, path)
+}
+
+func (a *admin) HandleFunc(path string, handler http.HandlerFunc) {
+	a.Handle(path, handler)
+}
+
+func (a *admin) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	a.mux.ServeHTTP(w, r)
+}
+
+func (a *admin) index(w http.ResponseWriter, _ *http.Request) {
+	a.mu.RLock()
+	paths := slices.Sorted(slices.Values(a.paths))
+	a.mu.RUnlock()
+	WriteJSON(w, http.StatusOK, map[string]any{"paths": paths})
+}
+
+// WriteJSON 输出 JSON 响应
+func WriteJSON(w http.ResponseWriter, code int, v any) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(code)
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	_ = enc.Encode(v)
+}
+
+// WithToken 校验 Authorization: Bearer {token}，token 为空时不校验
+func WithToken(token string, handler http.Handler) http.Handler {
+	if token == "" {
+		return handler
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if subtle.ConstantTimeCompare([]byte(r.Header.Get("Authorization")), []byte("Bearer "+token)) != 1 {
+			WriteJSON(w, http.StatusUnauthorized, map[string]any{"error": "unauthorized"})
+			return
+		}
+		handler.ServeHTTP(w, r)
+	})
+}
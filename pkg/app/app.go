@@ -26,7 +26,9 @@ import (
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/registry"
 	"github.com/jaggerzhuang1994/kratos-foundation/pkg/app_info"
+	"github.com/jaggerzhuang1994/kratos-foundation/pkg/health"
 	"github.com/jaggerzhuang1994/kratos-foundation/pkg/job"
+	log2 "github.com/jaggerzhuang1994/kratos-foundation/pkg/log"
 	"github.com/jaggerzhuang1994/kratos-foundation/pkg/server"
 	"github.com/jaggerzhuang1994/kratos-foundation/pkg/utils"
 	"github.com/jaggerzhuang1994/kratos-foundation/proto/kratos_foundation_pb/config_pb"
@@ -47,6 +49,7 @@ import (
 //   - logger:     日志记录器，用于记录应用日志
 //   - serverProvider: 服务器提供者，提供 HTTP/gRPC 等服务器
 //   - registrar:  服务注册中心（Consul 等），用于服务发现
+//   - hc:         健康检查，停机开始时标记为不可用
 //
 // 配置流程：
 //  1. 合并应用元信息（配置文件 + appInfo）
@@ -73,6 +76,7 @@ import (
 //	    logger,
 //	    serverProvider,
 //	    registrar,
+//	    hc,
 //	)
 //	if err != nil {
 //	    log.Fatal(err)
@@ -88,6 +92,8 @@ import (
 //   - 元数据合并规则：appInfo 覆盖配置文件中的值
 //   - 服务注册需要配置 Registrar 和启用注册功能
 func NewApp(
+	_ ConfigDumper, // 配置导出（放在最前面，DumpConfig 在创建数据库、redis、客户端和服务端之前执行）
+	_ log2.ModuleLevelAdmin, // 模块日志级别管理接口
 	_ Bootstrap, // 禁止在 bootstrap 注入 app（防止循环依赖）
 	_ job.Bootstrap, // 注入 job 服务
 	_ server.Bootstrap, // 注入 server 服务
@@ -98,6 +104,7 @@ func NewApp(
 	logger log.Logger,
 	serverProvider server.Register,
 	registrar registry.Registrar,
+	hc health.Health,
 ) *kratos.App {
 	var options []kratos.Option
 
@@ -194,6 +201,12 @@ func NewApp(
 
 	// 停止前钩子：在服务器停止之前执行
 	// 适用场景：优雅关闭连接、释放资源、持久化数据等
+	// 内置钩子最先执行：服务注销前将就绪检查置为失败（gRPC 健康服务返回 NOT_SERVING），
+	// 让负载均衡尽快摘除流量，之后由 server 的排空机制等待在途请求完成
+	options = append(options, kratos.BeforeStop(func(context.Context) error {
+		hc.Shutdown()
+		return nil
+	}))
 	for _, beforeStop := range hook_.BeforeStopHooks() {
 		options = append(options, kratos.BeforeStop(beforeStop))
 	}
@@ -0,0 +1,312 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/jaggerzhuang1994/kratos-foundation/internal/secret"
+	"github.com/jaggerzhuang1994/kratos-foundation/pkg/admin"
+	"github.com/jaggerzhuang1994/kratos-foundation/pkg/client"
+	config2 "github.com/jaggerzhuang1994/kratos-foundation/pkg/config"
+	"github.com/jaggerzhuang1994/kratos-foundation/pkg/database"
+	"github.com/jaggerzhuang1994/kratos-foundation/pkg/discovery"
+	"github.com/jaggerzhuang1994/kratos-foundation/pkg/env"
+	"github.com/jaggerzhuang1994/kratos-foundation/pkg/job"
+	log2 "github.com/jaggerzhuang1994/kratos-foundation/pkg/log"
+	"github.com/jaggerzhuang1994/kratos-foundation/pkg/metrics"
+	"github.com/jaggerzhuang1994/kratos-foundation/pkg/redis"
+	"github.com/jaggerzhuang1994/kratos-foundation/pkg/registry"
+	"github.com/jaggerzhuang1994/kratos-foundation/pkg/server"
+	"github.com/jaggerzhuang1994/kratos-foundation/pkg/tracing"
+	"github.com/jaggerzhuang1994/kratos-foundation/proto/kratos_foundation_pb"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// DumpConfigEnv 打印生效配置并退出的环境变量
+//
+// 示例：DUMP_CONFIG=true ./server -conf ../../configs
+const DumpConfigEnv = "DUMP_CONFIG"
+
+// DumpConfig 打印生效配置并退出，默认读取环境变量 DUMP_CONFIG
+//
+// 需要命令行参数时由 main 自己注册，例如：
+//
+//	flag.BoolVar(&app.DumpConfig, "dump-config", app.DumpConfig, "print the effective config and exit")
+var DumpConfig = env.GetEnvAsBool(DumpConfigEnv)
+
+// ErrConfigDumped 已经打印了生效配置（见 DumpConfig），NewConfigDumper 返回这个错误中止依赖注入，
+// wire 生成的代码会执行已经创建的依赖的 cleanup，main 收到后正常退出
+//
+//	a, cleanup, err := wireApp(app_info.Version(Version), conf.FileConfigSource(flagconf))
+//	if errors.Is(err, app.ErrConfigDumped) {
+//	    return
+//	}
+var ErrConfigDumped = errors.New("config dumped")
+
+// ConfigDumpPath 配置导出的管理接口路径（相对于管理接口前缀）
+const ConfigDumpPath = "/config"
+
+// DefaultConfigSource 只使用了默认值的字段的配置源名称
+const DefaultConfigSource = "default"
+
+// ConfigDump 生效配置的导出结果
+type ConfigDump struct {
+	// Layers 配置源列表，优先级从低到高
+	Layers []string `json:"layers"`
+	// Config 合并了各模块默认值之后的生效配置，敏感字段已脱敏
+	Config map[string]any `json:"config"`
+	// Sources 字段路径 => 生效值所在的配置源，只使用默认值的字段为 default
+	Sources map[string]string `json:"sources"`
+}
+
+// ConfigDumper 生效配置导出
+//
+// 各模块在 NewConfig 中各自合并默认配置，ConfigDumper 把所有 config_pb 配置段
+// 按相同的规则合并后统一输出，并标注每个字段来自哪个配置源（文件、consul 路径、环境变量）。
+//
+// 导出方式：
+//   - 管理接口：GET {server.http.admin.path}/config
+//   - DumpConfig（环境变量 DUMP_CONFIG）：打印后返回 ErrConfigDumped
+type ConfigDumper interface {
+	Dump() (*ConfigDump, error)
+}
+
+// ConfigDefaults 各模块的默认配置
+type ConfigDefaults struct {
+	App       DefaultConfig
+	Log       log2.DefaultConfig
+	Metrics   metrics.DefaultConfig
+	Tracing   tracing.DefaultConfig
+	Server    server.DefaultConfig
+	Database  database.DefaultConfig
+	Discovery discovery.DefaultConfig
+	Redis     redis.DefaultConfig
+	Registry  registry.DefaultConfig
+	Client    client.DefaultConfig
+	Job       job.DefaultConfig
+}
+
+// NewDefaultKratosFoundationConfig 汇总各模块的默认配置，框架配置的语义校验按它补全未配置的字段
+func NewDefaultKratosFoundationConfig(defaults ConfigDefaults) config2.DefaultKratosFoundationConfig {
+	return &kratos_foundation_pb.Config{
+		App:       defaults.App,
+		Log:       defaults.Log,
+		Metrics:   defaults.Metrics,
+		Tracing:   defaults.Tracing,
+		Server:    defaults.Server,
+		Database:  defaults.Database,
+		Discovery: defaults.Discovery,
+		Redis:     defaults.Redis,
+		Registry:  defaults.Registry,
+		Client:    defaults.Client,
+		Job:       defaults.Job,
+	}
+}
+
+type configDumper struct {
+	defaults ConfigDefaults
+	watch    config2.Watch
+	layers   config2.ConfigSourceLayers
+	resolver config2.SecretResolver
+}
+
+// NewConfigDumper 创建生效配置导出，并注册管理接口
+//
+// 开启了 DumpConfig 时在这里打印生效配置并返回 ErrConfigDumped，
+// NewApp 把它作为第一个依赖，在创建数据库、redis、客户端和服务端之前执行；
+// 配置源（包括 consul）在加载配置时已经建立连接
+func NewConfigDumper(
+	defaults ConfigDefaults,
+	watch config2.Watch,
+	layers config2.ConfigSourceLayers,
+	resolver config2.SecretResolver,
+	adm admin.Admin,
+) (ConfigDumper, error) {
+	d := &configDumper{
+		defaults: defaults,
+		watch:    watch,
+		layers:   layers,
+		resolver: resolver,
+	}
+
+	if DumpConfig {
+		dump, err := d.Dump()
+		if err != nil {
+			return nil, err
+		}
+		data, err := json.MarshalIndent(dump, "", "  ")
+		if err != nil {
+			return nil, err
+		}
+		_, _ = fmt.Fprintln(os.Stdout, string(data))
+		return nil, ErrConfigDumped
+	}
+
+	adm.HandleFunc(ConfigDumpPath, func(w http.ResponseWriter, _ *http.Request) {
+		dump, err := d.Dump()
+		if err != nil {
+			admin.WriteJSON(w, http.StatusInternalServerError, map[string]any{"error": err.Error()})
+			return
+		}
+		admin.WriteJSON(w, http.StatusOK, dump)
+	})
+	return d, nil
+}
+
+func (d *configDumper) Dump() (*ConfigDump, error) {
+	effective, err := toMap(d.effective())
+	if err != nil {
+		return nil, err
+	}
+
+	// 解析每个配置源，用于标注字段来源
+	layers := d.layers.Layers()
+	names := make([]string, 0, len(layers))
+	values := make([]map[string]any, 0, len(layers))
+	for _, layer := range layers {
+		v, err := layer.Decode()
+		if err != nil {
+			return nil, err
+		}
+		names = append(names, layer.Name)
+		values = append(values, v)
+	}
+
+	dump := &ConfigDump{
+		Layers:  names,
+		Config:  effective,
+		Sources: map[string]string{},
+	}
+	d.walk(nil, effective, func(path []string) {
+		source := DefaultConfigSource
+		for i := len(values) - 1; i >= 0; i-- {
+			if hasPath(values[i], path) {
+				source = names[i]
+				break
+			}
+		}
+		dump.Sources[strings.Join(path, ".")] = source
+	})
+	return dump, nil
+}
+
+// effective 合并各模块默认配置，与各模块 NewConfig 的规则一致
+func (d *configDumper) effective() *kratos_foundation_pb.Config {
+	c := d.watch.Current()
+	logConfig := proto.CloneOf((log2.Config)(d.defaults.Log))
+	proto.Merge(logConfig, c.GetLog())
+	return &kratos_foundation_pb.Config{
+		App:       NewConfig(c, d.defaults.App),
+		Log:       logConfig,
+		Metrics:   metrics.NewConfig(c, d.defaults.Metrics),
+		Tracing:   tracing.NewConfig(c, d.defaults.Tracing),
+		Server:    server.NewConfig(c, d.defaults.Server),
+		Database:  database.NewConfig(c, d.defaults.Database),
+		Discovery: discovery.NewConfig(c, d.defaults.Discovery),
+		Redis:     redis.NewConfig(c, d.defaults.Redis),
+		Registry:  registry.NewConfig(c, d.defaults.Registry),
+		Client:    client.NewConfig(c, d.defaults.Client),
+		Job:       job.NewConfig(c, d.defaults.Job),
+	}
+}
+
+// walk 遍历所有叶子字段（list 视为叶子），对敏感字段脱敏
+func (d *configDumper) walk(path []string, m map[string]any, visit func(path []string)) {
+	for k, v := range m {
+		p := append(path[:len(path):len(path)], k)
+		if sub, ok := v.(map[string]any); ok && len(sub) > 0 {
+			d.walk(p, sub, visit)
+			continue
+		}
+		visit(p)
+		m[k] = d.mask(p, v)
+	}
+}
+
+// mask 对敏感字段脱敏，map 和 list 中的元素（例如 replicas[].dsn）递归处理
+func (d *configDumper) mask(path []string, v any) any {
+	if d.sensitive(path) {
+		return secret.Mask
+	}
+	switch v := v.(type) {
+	case string:
+		return secret.Redact(v)
+	case map[string]any:
+		for k, item := range v {
+			v[k] = d.mask(append(path[:len(path):len(path)], k), item)
+		}
+		return v
+	case []any:
+		for i, item := range v {
+			// 与 SecretResolver 记录的路径一致，例如 database.connections.main.replicas[0].dsn
+			p := slices.Clone(path)
+			p[len(p)-1] += "[" + strconv.Itoa(i) + "]"
+			v[i] = d.mask(p, item)
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+func (d *configDumper) sensitive(path []string) bool {
+	if slices.ContainsFunc(path, config2.IsSensitiveField) {
+		return true
+	}
+	return d.resolver.IsSecret(strings.Join(path, "."))
+}
+
+func toMap(m proto.Message) (map[string]any, error) {
+	data, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	var v map[string]any
+	if err = json.Unmarshal(data, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// hasPath 配置源中是否设置了该字段
+//
+// 配置文件中的字段名可以是 proto 字段名（snake_case）或 json 名（lowerCamelCase），
+// 因此比较时忽略下划线和大小写；路径中间遇到非 map 的值（例如整体设置的 list）也视为设置了该字段
+func hasPath(values map[string]any, path []string) bool {
+	var cur any = values
+	for _, p := range path {
+		m, ok := cur.(map[string]any)
+		if !ok {
+			return true
+		}
+		cur, ok = lookupKey(m, p)
+		if !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func lookupKey(m map[string]any, key string) (any, bool) {
+	if v, ok := m[key]; ok {
+		return v, true
+	}
+	normalized := normalizeKey(key)
+	for k, v := range m {
+		if normalizeKey(k) == normalized {
+			return v, true
+		}
+	}
+	return nil, false
+}
+
+func normalizeKey(key string) string {
+	return strings.ToLower(strings.ReplaceAll(key, "_", ""))
+}
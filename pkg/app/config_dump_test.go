@@ -0,0 +1,61 @@
+package app
+
+import (
+	"testing"
+
+	config2 "github.com/jaggerzhuang1994/kratos-foundation/pkg/config"
+	log2 "github.com/jaggerzhuang1994/kratos-foundation/pkg/log"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestConfigDumperMask(t *testing.T) {
+	logConfig := log2.NewDefaultConfig()
+	logConfig.File.Disable = proto.Bool(true)
+	logger, release, err := log2.NewLogger(log2.PresetKv{}, logConfig, log2.NewHook(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer release()
+
+	resolver := config2.NewSecretResolver(nil, log2.NewLog(logger))
+	t.Setenv("REPLICA_PASSWORD", "replicapw")
+	config := map[string]any{
+		"database": map[string]any{
+			"connections": map[string]any{
+				"main": map[string]any{
+					"dsn": "u:primarypw@tcp(x)/db",
+					"replicas": []any{
+						map[string]any{"dsn": "u:replicapw@tcp(y)/db"},
+						map[string]any{"dsn_template": "u:${env:REPLICA_PASSWORD}@tcp(z)/db"},
+					},
+				},
+			},
+		},
+	}
+	if err = resolver.Resolve(config); err != nil {
+		t.Fatal(err)
+	}
+
+	d := &configDumper{resolver: resolver}
+	var paths []string
+	d.walk(nil, config, func(path []string) {
+		paths = append(paths, path[len(path)-1])
+	})
+
+	main := config["database"].(map[string]any)["connections"].(map[string]any)["main"].(map[string]any)
+	if main["dsn"] != "******" {
+		t.Errorf("dsn = %v", main["dsn"])
+	}
+	replicas := main["replicas"].([]any)
+	if dsn := replicas[0].(map[string]any)["dsn"]; dsn != "******" {
+		t.Errorf("replicas[0].dsn = %v", dsn)
+	}
+	// list 中通过占位符解析出敏感值的字段同样脱敏
+	if dsn := replicas[1].(map[string]any)["dsn_template"]; dsn != "******" {
+		t.Errorf("replicas[1].dsn_template = %v", dsn)
+	}
+	// list 作为一个叶子字段标注配置源
+	if len(paths) != 2 {
+		t.Errorf("paths = %v", paths)
+	}
+}
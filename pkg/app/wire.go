@@ -8,5 +8,8 @@ var ProviderSet = wire.NewSet(
 	NewDefaultConfig,
 	NewConfig,
 	NewHook,
+	wire.Struct(new(ConfigDefaults), "*"),
+	NewDefaultKratosFoundationConfig,
+	NewConfigDumper,
 	NewApp,
 )
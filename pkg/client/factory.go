@@ -5,12 +5,16 @@ import (
 	"sync"
 
 	"github.com/go-kratos/kratos/v2/transport/http"
+	config2 "github.com/jaggerzhuang1994/kratos-foundation/pkg/config"
 	"github.com/jaggerzhuang1994/kratos-foundation/pkg/discovery"
+	"github.com/jaggerzhuang1994/kratos-foundation/pkg/health"
 	"github.com/jaggerzhuang1994/kratos-foundation/pkg/log"
 	"github.com/jaggerzhuang1994/kratos-foundation/pkg/metrics"
 	"github.com/jaggerzhuang1994/kratos-foundation/pkg/tracing"
 	"github.com/jaggerzhuang1994/kratos-foundation/proto/kratos_foundation_pb/config_pb"
+	"github.com/pkg/errors"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
 )
 
 type HTTPClient = *http.Client
@@ -28,6 +32,7 @@ type factory struct {
 	tracing   tracing.Tracing
 	metrics   metrics.Metrics
 	discovery discovery.Discovery
+	health    health.Health
 
 	clientOptions map[string]Option
 	// 初始化连接的锁
@@ -35,6 +40,12 @@ type factory struct {
 	// 客户端缓存 map[ clientKey ] -> HTTPClient | GRPCClient
 	httpClients sync.Map
 	grpcClients sync.Map
+
+	// 支持热更新的中间件 map[ clientName ] -> []*reloadableMiddleware
+	reloadLock            sync.Mutex
+	reloadableMiddlewares map[string][]*reloadableMiddleware
+	// 热更新后最新的中间件配置，用于之后新建的客户端
+	middlewareConfigs map[string]*config_pb.ClientMiddleware
 }
 
 func NewFactory(
@@ -43,15 +54,40 @@ func NewFactory(
 	tracing tracing.Tracing,
 	metrics metrics.Metrics,
 	discovery discovery.Discovery,
-) Factory {
-	return &factory{
-		Log:           log.WithModule("client", config.GetLog()),
-		log:           log,
-		tracing:       tracing,
-		metrics:       metrics,
-		discovery:     discovery,
-		clientOptions: config.GetClients(),
+	health health.Health,
+	watch config2.Watch,
+	defaultConfig DefaultConfig,
+) (Factory, func()) {
+	f := &factory{
+		Log:                   log.WithModule("client", config.GetLog()),
+		log:                   log,
+		tracing:               tracing,
+		metrics:               metrics,
+		discovery:             discovery,
+		health:                health,
+		clientOptions:         config.GetClients(),
+		reloadableMiddlewares: map[string][]*reloadableMiddleware{},
+		middlewareConfigs:     map[string]*config_pb.ClientMiddleware{},
 	}
+	// 客户端的 target、protocol 等变更需要重建连接，只热更新中间件参数
+	watch.Subscribe(config2.SectionClient, func(c, _ config2.KratosFoundationConfig) error {
+		f.reloadMiddlewares(NewConfig(c, defaultConfig))
+		return nil
+	})
+	return f, f.release
+}
+
+// release 注销 grpc 连接的健康检查并关闭连接
+func (f *factory) release() {
+	f.grpcClients.Range(func(key, value any) bool {
+		clientKey := key.(clientConfig)
+		f.health.Unregister("client." + clientKey.name)
+		if err := value.(GRPCClient).Close(); err != nil {
+			f.Warnf("client close failed, name=%s err=%v", clientKey.name, err)
+		}
+		f.grpcClients.Delete(key)
+		return true
+	})
 }
 
 func (f *factory) MakeGrpcConn(ctx context.Context) (grpcClient GRPCClient, err error) {
@@ -136,6 +172,7 @@ func (f *factory) resolveClient(ctx context.Context, optionalProtocol ...config_
 			return
 		}
 		f.grpcClients.Store(clientKey, grpcClient)
+		f.registerGrpcHealthCheck(clientKey.name, grpcClient)
 		return
 	}
 	if clientKey.protocol == config_pb.Protocol_HTTP || clientKey.protocol == config_pb.Protocol_HTTPS {
@@ -148,3 +185,15 @@ func (f *factory) resolveClient(ctx context.Context, optionalProtocol ...config_
 	}
 	return
 }
+
+// registerGrpcHealthCheck 注册 grpc 连接的健康检查
+// 下游服务不可用不代表本服务不可用，因此注册为可选检查项
+func (f *factory) registerGrpcHealthCheck(name string, conn GRPCClient) {
+	f.health.Register("client."+name, func(context.Context) error {
+		switch state := conn.GetState(); state {
+		case connectivity.TransientFailure, connectivity.Shutdown:
+			return errors.Errorf("grpc client [%s] state is %s", name, state)
+		}
+		return nil
+	}, health.WithOptional())
+}
@@ -3,23 +3,42 @@ package client
 import (
 	"github.com/go-kratos/kratos/v2/middleware"
 	"github.com/jaggerzhuang1994/kratos-foundation/internal/middleware/circuitbreaker"
+	"github.com/jaggerzhuang1994/kratos-foundation/internal/middleware/dynamic"
 	"github.com/jaggerzhuang1994/kratos-foundation/internal/middleware/logging"
 	"github.com/jaggerzhuang1994/kratos-foundation/internal/middleware/metadata"
 	"github.com/jaggerzhuang1994/kratos-foundation/internal/middleware/metrics"
 	"github.com/jaggerzhuang1994/kratos-foundation/internal/middleware/timeout"
 	"github.com/jaggerzhuang1994/kratos-foundation/internal/middleware/tracing"
 	"github.com/jaggerzhuang1994/kratos-foundation/pkg/utils"
+	"github.com/jaggerzhuang1994/kratos-foundation/proto/kratos_foundation_pb/config_pb"
+	"google.golang.org/protobuf/proto"
 )
 
+// reloadableMiddleware 客户端支持热更新的中间件
+type reloadableMiddleware struct {
+	config         *config_pb.ClientMiddleware
+	timeout        *dynamic.Middleware
+	circuitBreaker *dynamic.Middleware
+}
+
 func (f *factory) newMiddleware(clientConfig clientConfig) []middleware.Middleware {
 	var m []middleware.Middleware
 
-	config := clientConfig.option.GetMiddleware()
+	f.reloadLock.Lock()
+	config, ok := f.middlewareConfigs[clientConfig.name]
+	if !ok {
+		config = clientConfig.option.GetMiddleware()
+	}
+	reloadable := &reloadableMiddleware{
+		config:         config,
+		timeout:        dynamic.New(timeout.Client(config.GetTimeout())),
+		circuitBreaker: dynamic.New(circuitbreaker.Client(config.GetCircuitBreaker())),
+	}
+	f.reloadableMiddlewares[clientConfig.name] = append(f.reloadableMiddlewares[clientConfig.name], reloadable)
+	f.reloadLock.Unlock()
 
 	// 超时中间件
-	m = append(m, timeout.Client(
-		config.GetTimeout(),
-	))
+	m = append(m, reloadable.timeout.Middleware())
 
 	// metadata
 	m = append(m, metadata.Client(config.GetMetadata()))
@@ -39,7 +58,7 @@ func (f *factory) newMiddleware(clientConfig clientConfig) []middleware.Middlewa
 	m = append(m, logging.Client(f.log, config.GetLogging()))
 
 	// 熔断器
-	m = append(m, circuitbreaker.Client(config.GetCircuitBreaker()))
+	m = append(m, reloadable.circuitBreaker.Middleware())
 
 	// 过滤 nil
 	m = utils.Filter(m, func(m middleware.Middleware) bool {
@@ -47,3 +66,28 @@ func (f *factory) newMiddleware(clientConfig clientConfig) []middleware.Middlewa
 	})
 	return m
 }
+
+// reloadMiddlewares 热更新客户端的超时和熔断中间件
+func (f *factory) reloadMiddlewares(config Config) {
+	f.reloadLock.Lock()
+	defer f.reloadLock.Unlock()
+
+	for name, option := range config.GetClients() {
+		f.middlewareConfigs[name] = option.GetMiddleware()
+	}
+	for name, reloadables := range f.reloadableMiddlewares {
+		conf := config.GetClients()[name].GetMiddleware()
+		f.middlewareConfigs[name] = conf
+		for _, r := range reloadables {
+			if !proto.Equal(r.config.GetTimeout(), conf.GetTimeout()) {
+				r.timeout.Update(timeout.Client(conf.GetTimeout()))
+				f.Infof("client timeout middleware reloaded, name=%s", name)
+			}
+			if !proto.Equal(r.config.GetCircuitBreaker(), conf.GetCircuitBreaker()) {
+				r.circuitBreaker.Update(circuitbreaker.Client(conf.GetCircuitBreaker()))
+				f.Infof("client circuitbreaker middleware reloaded, name=%s", name)
+			}
+			r.config = conf
+		}
+	}
+}
@@ -0,0 +1,405 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"net/http"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/config"
+	"github.com/jaggerzhuang1994/kratos-foundation/internal/secret"
+	"github.com/jaggerzhuang1994/kratos-foundation/pkg/admin"
+	"github.com/jaggerzhuang1994/kratos-foundation/pkg/env"
+	"github.com/jaggerzhuang1994/kratos-foundation/pkg/log"
+	"github.com/pkg/errors"
+)
+
+// ConfigHistoryPath 配置变更记录的管理接口路径（相对于管理接口前缀）
+const ConfigHistoryPath = "/config/history"
+
+// ConfigHistorySizeEnv 配置变更记录条数的环境变量
+const ConfigHistorySizeEnv = "CONFIG_HISTORY_SIZE"
+
+// ConfigHistorySize 内存中保留的配置变更记录条数，默认 100
+var ConfigHistorySize = env.GetEnvAsInt(ConfigHistorySizeEnv, 100)
+
+// 配置重新加载的结果
+const (
+	ReloadResultSuccess  = "success"  // 配置已更新
+	ReloadResultRejected = "rejected" // 配置文档 schema 校验或 KratosFoundationConfig 校验失败，丢弃变更
+	ReloadResultFailed   = "failed"   // 解析、合并或 Scan 失败，丢弃变更
+)
+
+// 字段变更类型
+const (
+	FieldAdded   = "added"
+	FieldRemoved = "removed"
+	FieldChanged = "changed"
+)
+
+// sensitiveFields 需要脱敏的字段名，字段路径中任意一段命中即脱敏
+var sensitiveFields = map[string]struct{}{
+	"dsn":      {},
+	"password": {},
+	"headers":  {},
+	"token":    {},
+	"tokens":   {},
+	"secret":   {},
+	// log.redact_hash_key
+	"redact_hash_key": {},
+}
+
+// IsSensitiveField 字段名是否需要脱敏（dsn、password、headers、token(s)、secret、redact_hash_key）
+func IsSensitiveField(name string) bool {
+	_, ok := sensitiveFields[name]
+	return ok
+}
+
+// FieldChange 单个字段的变更
+type FieldChange struct {
+	// Path 字段路径，例如 server.http.addr；列表作为一个整体对比
+	Path string `json:"path"`
+	// Op added、removed 或 changed
+	Op string `json:"op"`
+	// Old 变更前的值，敏感字段已脱敏
+	Old any `json:"old,omitempty"`
+	// New 变更后的值，敏感字段已脱敏
+	New any `json:"new,omitempty"`
+}
+
+// ConfigChangeEvent 一次配置重新加载的记录
+type ConfigChangeEvent struct {
+	// Time 本实例收到变更的时间
+	Time time.Time `json:"time"`
+	// Result 结果，见 ReloadResult* 常量
+	Result string `json:"result"`
+	// Sources 内容发生变化的配置源
+	Sources []string `json:"sources,omitempty"`
+	// Changes 合并后配置的字段变更（包括业务配置）
+	Changes []FieldChange `json:"changes,omitempty"`
+	// Error 失败原因
+	Error string `json:"error,omitempty"`
+}
+
+// ConfigAudit 配置变更审计
+//
+// 配置源每次 watch 到新的内容，都会对比合并前后的完整配置（框架配置和业务配置），
+// 输出结构化日志 config changed，并在内存中保留最近 ConfigHistorySize 条记录：
+//   - 管理接口：GET {server.http.admin.path}/config/history
+//   - 指标：config_reload_total{result}
+//
+// 合并后内容没有变化的 watch（例如 consul 重新写入相同的值）不记录。
+// 变更在 Watch 重新 Scan 并校验 KratosFoundationConfig 之后才记录结果，
+// 校验失败记录为 rejected，Scan 失败记录为 failed，下一次变更仍与最后一次生效的配置对比。
+// 注意：schema 校验或解析失败的变更不会交给 kratos config，整体丢弃；
+// Scan 或校验 KratosFoundationConfig 失败时 kratos config 已经合并了新的配置项，
+// 只有 KratosFoundationConfig（Watch.Current 及其订阅者）保留旧配置，Config.Value 和业务配置段读到的是新的值。
+type ConfigAudit interface {
+	// History 最近的配置变更记录，按时间从旧到新排列
+	History() []ConfigChangeEvent
+	// ReloadTotal 按结果统计的累计重新加载次数
+	ReloadTotal() map[string]int64
+}
+
+type configAudit struct {
+	log  log.Log
+	size int
+
+	mu      sync.RWMutex
+	history []ConfigChangeEvent
+	total   map[string]int64
+	// pending 已经交给 kratos config、等待 Watch 校验结果的变更
+	pending *pendingChange
+}
+
+// pendingChange 等待校验结果的变更，校验通过后 source 才更新为 next
+type pendingChange struct {
+	source *auditedSource
+	next   map[string]any
+	event  ConfigChangeEvent
+}
+
+// NewConfigAudit 创建配置变更审计，并注册管理接口
+func NewConfigAudit(log log.Log, adm admin.Admin) ConfigAudit {
+	a := &configAudit{
+		log:   log.WithModule("config/audit"),
+		size:  max(ConfigHistorySize, 1),
+		total: map[string]int64{},
+	}
+	adm.HandleFunc(ConfigHistoryPath, func(w http.ResponseWriter, _ *http.Request) {
+		admin.WriteJSON(w, http.StatusOK, map[string]any{
+			"history":      a.History(),
+			"reload_total": a.ReloadTotal(),
+		})
+	})
+	return a
+}
+
+func (a *configAudit) History() []ConfigChangeEvent {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return slices.Clone(a.history)
+}
+
+func (a *configAudit) ReloadTotal() map[string]int64 {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return maps.Clone(a.total)
+}
+
+// record 记录一次重新加载并输出结构化日志，failMsg 为失败时的日志内容
+func (a *configAudit) record(event ConfigChangeEvent, failMsg string) {
+	a.mu.Lock()
+	a.total[event.Result]++
+	a.history = append(a.history, event)
+	if len(a.history) > a.size {
+		a.history = slices.Clone(a.history[len(a.history)-a.size:])
+	}
+	a.mu.Unlock()
+
+	if event.Result == ReloadResultSuccess {
+		a.log.Infow("msg", "config changed", "result", event.Result, "sources", event.Sources, "changes", event.Changes)
+		return
+	}
+	a.log.Errorw("msg", failMsg, "result", event.Result,
+		"sources", event.Sources, "error", event.Error)
+}
+
+// resolve 记录等待校验的变更，result 为 ReloadResultSuccess 时才更新最后一次生效的配置
+// 没有等待校验的变更时忽略，kratos 对每个变更的 key 分别回调，只有第一次 reload 的结果生效
+func (a *configAudit) resolve(result string, err error) {
+	a.mu.Lock()
+	pending := a.pending
+	a.pending = nil
+	a.mu.Unlock()
+	if pending == nil {
+		return
+	}
+	event := pending.event
+	event.Result = result
+	if result == ReloadResultSuccess {
+		pending.source.commit(pending.next)
+	} else if err != nil {
+		event.Error = err.Error()
+	}
+	// kratos config 已经合并了新的配置项，Config.Value 和业务配置段（TypedSection 自行校验）能读到新的值，
+	// 只有 KratosFoundationConfig 保留旧配置
+	a.record(event, "config change rejected, keep current KratosFoundationConfig only")
+}
+
+// auditedSource 对比每次 watch 合并后的配置，记录到 ConfigAudit
+//
+// source 为 mergedSource，layers 为合并前的配置源，用于找出内容变化的配置源
+type auditedSource struct {
+	Source
+	audit  *configAudit
+	layers ConfigSourceLayers
+
+	mu      sync.Mutex
+	current map[string]any
+	// snapshot 每个配置源最近一次的内容
+	snapshot map[string][]*KeyValue
+}
+
+// withAudit 包装合并后的配置源，audit 为 nil 时直接返回 source
+func withAudit(audit ConfigAudit, layers ConfigSourceLayers, source Source) Source {
+	a, ok := audit.(*configAudit)
+	if !ok {
+		return source
+	}
+	return &auditedSource{Source: source, audit: a, layers: layers}
+}
+
+func (s *auditedSource) Load() ([]*KeyValue, error) {
+	kvs, err := s.Source.Load()
+	if err != nil {
+		return nil, err
+	}
+	current, err := decodeMerged(kvs)
+	if err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.current = current
+	s.changedSources()
+	return kvs, nil
+}
+
+func (s *auditedSource) Watch() (config.Watcher, error) {
+	w, err := s.Source.Watch()
+	if err != nil {
+		return nil, err
+	}
+	return &auditedWatcher{Watcher: w, source: s}, nil
+}
+
+// changedSources 与上一次相比内容发生变化的配置源，并更新 snapshot
+func (s *auditedSource) changedSources() []string {
+	var changed []string
+	snapshot := map[string][]*KeyValue{}
+	for _, layer := range s.layers.Layers() {
+		snapshot[layer.Name] = layer.KeyValues
+		if !equalKeyValues(s.snapshot[layer.Name], layer.KeyValues) {
+			changed = append(changed, layer.Name)
+		}
+	}
+	s.snapshot = snapshot
+	return changed
+}
+
+// update 与最后一次生效的配置对比，内容没有变化时返回 false
+// 发生变化时记录为等待校验的变更，由 Watch 校验后调用 configAudit.resolve 记录结果
+func (s *auditedSource) update(kvs []*KeyValue) (bool, error) {
+	next, err := decodeMerged(kvs)
+	if err != nil {
+		return false, err
+	}
+	s.mu.Lock()
+	sources := s.changedSources()
+	changes := diffValues(nil, s.current, next)
+	s.mu.Unlock()
+	if len(changes) == 0 {
+		return false, nil
+	}
+	s.audit.mu.Lock()
+	s.audit.pending = &pendingChange{
+		source: s,
+		next:   next,
+		event: ConfigChangeEvent{
+			Time:    time.Now(),
+			Sources: sources,
+			Changes: changes,
+		},
+	}
+	s.audit.mu.Unlock()
+	return true, nil
+}
+
+// commit 校验通过，更新最后一次生效的配置
+func (s *auditedSource) commit(next map[string]any) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.current = next
+}
+
+// fail 记录失败的重新加载
+func (s *auditedSource) fail(err error) {
+	result := ReloadResultFailed
+	var schemaErrs SchemaErrors
+	if errors.As(err, &schemaErrs) {
+		result = ReloadResultRejected
+	}
+	s.mu.Lock()
+	sources := s.changedSources()
+	s.mu.Unlock()
+	s.audit.record(ConfigChangeEvent{
+		Time:    time.Now(),
+		Result:  result,
+		Sources: sources,
+		Error:   err.Error(),
+	}, "config change discarded, keep current config")
+}
+
+type auditedWatcher struct {
+	config.Watcher
+	source *auditedSource
+}
+
+// Next 返回发生变化的配置；失败的变更记录后丢弃，继续等待下一次变更
+//
+// kratos config 在同一个协程中串行调用 Next 和变更回调，再次调用 Next 时上一次的变更已经处理完，
+// 仍在等待校验说明没有触发 Watch 的 reload（例如只变更了业务配置），记录为 success
+func (w *auditedWatcher) Next() ([]*KeyValue, error) {
+	w.source.audit.resolve(ReloadResultSuccess, nil)
+	for {
+		kvs, err := w.Watcher.Next()
+		if errors.Is(err, context.Canceled) {
+			return nil, err
+		}
+		if err != nil {
+			w.source.fail(err)
+			continue
+		}
+		changed, err := w.source.update(kvs)
+		if err != nil {
+			w.source.fail(err)
+			continue
+		}
+		if changed {
+			return kvs, nil
+		}
+	}
+}
+
+// decodeMerged 解析 mergedSource 返回的配置项
+func decodeMerged(kvs []*KeyValue) (map[string]any, error) {
+	values := map[string]any{}
+	for _, kv := range kvs {
+		next, err := decodeKeyValue(kv)
+		if err != nil {
+			return nil, err
+		}
+		mergeMap(values, next)
+	}
+	return values, nil
+}
+
+// diffValues 逐字段对比，map 递归对比，其他值（包括列表）整体对比
+func diffValues(path []string, a, b map[string]any) []FieldChange {
+	var changes []FieldChange
+	keys := slices.Collect(maps.Keys(a))
+	for k := range b {
+		if _, ok := a[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	slices.Sort(keys)
+	for _, k := range keys {
+		p := append(path[:len(path):len(path)], k)
+		av, bv := a[k], b[k]
+		am, amap := av.(map[string]any)
+		bm, bmap := bv.(map[string]any)
+		switch {
+		case amap && bmap:
+			changes = append(changes, diffValues(p, am, bm)...)
+		case av == nil && bv != nil:
+			changes = append(changes, FieldChange{Path: strings.Join(p, "."), Op: FieldAdded, New: maskValue(p, bv)})
+		case av != nil && bv == nil:
+			changes = append(changes, FieldChange{Path: strings.Join(p, "."), Op: FieldRemoved, Old: maskValue(p, av)})
+		case !reflect.DeepEqual(av, bv):
+			changes = append(changes, FieldChange{Path: strings.Join(p, "."), Op: FieldChanged, Old: maskValue(p, av), New: maskValue(p, bv)})
+		}
+	}
+	return changes
+}
+
+// maskValue 敏感字段脱敏，其他字符串中的敏感值替换为掩码
+func maskValue(path []string, v any) any {
+	if slices.ContainsFunc(path, IsSensitiveField) {
+		return secret.Mask
+	}
+	switch v := v.(type) {
+	case string:
+		return secret.Redact(v)
+	case map[string]any:
+		m := make(map[string]any, len(v))
+		for k, item := range v {
+			m[k] = maskValue(append(path[:len(path):len(path)], k), item)
+		}
+		return m
+	case []any:
+		l := make([]any, len(v))
+		for i, item := range v {
+			l[i] = maskValue(append(path[:len(path):len(path)], fmt.Sprint(i)), item)
+		}
+		return l
+	default:
+		return v
+	}
+}
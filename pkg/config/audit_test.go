@@ -0,0 +1,75 @@
+package config
+
+import (
+	"testing"
+
+	log2 "github.com/jaggerzhuang1994/kratos-foundation/pkg/log"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+)
+
+type testSourceLayers []SourceLayer
+
+func (l testSourceLayers) Layers() []SourceLayer {
+	return l
+}
+
+func newTestAuditedSource(t *testing.T, current string) *auditedSource {
+	t.Helper()
+	// 测试中不输出日志文件
+	logConfig := log2.NewDefaultConfig()
+	logConfig.File.Disable = proto.Bool(true)
+	logger, _, err := log2.NewLogger(log2.PresetKv{}, logConfig, log2.NewHook(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a := &configAudit{log: log2.NewLog(logger), size: 10, total: map[string]int64{}}
+	s := &auditedSource{audit: a, layers: testSourceLayers{}}
+	next, err := decodeMerged([]*KeyValue{{Key: "config.yaml", Value: []byte(current), Format: "yaml"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.current = next
+	return s
+}
+
+func TestAuditResolveAfterValidation(t *testing.T) {
+	s := newTestAuditedSource(t, "server:\n  http:\n    addr: 0.0.0.0:8000\n")
+	update := func(value string) {
+		t.Helper()
+		changed, err := s.update([]*KeyValue{{Key: "config.yaml", Value: []byte(value), Format: "yaml"}})
+		if err != nil || !changed {
+			t.Fatalf("update = %v, %v", changed, err)
+		}
+	}
+
+	// 校验失败：记录为 rejected，最后一次生效的配置不变
+	update("server:\n  http:\n    addr: bad\n")
+	if history := s.audit.History(); len(history) != 0 {
+		t.Fatalf("recorded before validation: %+v", history)
+	}
+	s.audit.resolve(ReloadResultRejected, errors.New("invalid addr"))
+	history := s.audit.History()
+	if len(history) != 1 || history[0].Result != ReloadResultRejected || history[0].Error != "invalid addr" {
+		t.Fatalf("history = %+v", history)
+	}
+	if got := s.current["server"].(map[string]any)["http"].(map[string]any)["addr"]; got != "0.0.0.0:8000" {
+		t.Errorf("current addr = %v, want 0.0.0.0:8000", got)
+	}
+
+	// 下一次变更与最后一次生效的配置对比
+	update("server:\n  http:\n    addr: 0.0.0.0:9000\n")
+	s.audit.resolve(ReloadResultSuccess, nil)
+	// 多次 reload 只记录第一次的结果
+	s.audit.resolve(ReloadResultSuccess, nil)
+	history = s.audit.History()
+	if len(history) != 2 || history[1].Result != ReloadResultSuccess {
+		t.Fatalf("history = %+v", history)
+	}
+	if changes := history[1].Changes; len(changes) != 1 || changes[0].Old != "0.0.0.0:8000" || changes[0].New != "0.0.0.0:9000" {
+		t.Errorf("changes = %+v", changes)
+	}
+	if total := s.audit.ReloadTotal(); total[ReloadResultSuccess] != 1 || total[ReloadResultRejected] != 1 {
+		t.Errorf("reload total = %v", total)
+	}
+}
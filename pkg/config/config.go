@@ -1,18 +1,25 @@
 // Package config 提供配置管理功能，支持多配置源合并与优先级控制
-// 支持的配置源包括文件配置和 Consul 配置中心
+// 支持的配置源包括文件配置、Consul 配置中心和环境变量
 package config
 
 import (
+	"strings"
+
 	"github.com/go-kratos/kratos/v2/config"
 	"github.com/jaggerzhuang1994/kratos-foundation/pkg/env"
+	"github.com/jaggerzhuang1994/kratos-foundation/pkg/log"
 	"github.com/jaggerzhuang1994/kratos-foundation/pkg/utils"
 )
 
-// NewConfig 创建配置实例，支持文件和 Consul 两种配置源
+// NewConfig 创建配置实例，支持文件、Consul 和环境变量三种配置源
 //
 // 参数：
+//   - log: 日志记录器，用于输出配置源优先级
 //   - fileSource: 文件配置源（如 config.yaml），可以为 nil
 //   - consulSource: Consul 配置源，可以为 nil
+//   - envSource: 环境变量配置源（KF_ 前缀），可以为 nil
+//   - secretResolver: 占位符解析器，用于解析 ${secret:file:..}、${env:..}、${consul:..} 等敏感值
+//   - audit: 配置变更审计，记录每次热更新的字段变更，可以为 nil
 //
 // 返回：
 //   - Config: 配置实例
@@ -24,37 +31,57 @@ import (
 //   - 便于本地开发调试，文件配置可以覆盖远程配置
 //   - 非本地环境：Consul 配置 > 文件配置
 //   - 生产环境优先使用远程配置，文件配置作为后备
+//   - 环境变量配置在任何环境下优先级都最高
+//   - 文件和 consul 内部按环境 profile 覆盖（见 profile.go）
+//   - 字段按 proto 中声明的合并策略合并（见 merge.proto）
+//   - 合并前按 config.schema.json 校验每个配置文档，未知字段默认输出警告，
+//     ConfigSchemaStrict 时视为错误（见 schema.go）
+//   - 启动时会输出完整的配置源优先级
 //
 // 注意事项：
-//   - 如果两个参数都为 nil，会返回空配置
+//   - 如果所有参数都为 nil，会返回空配置
 //   - 配置加载失败时会自动清理资源
 //   - 调用方负责在程序退出时调用清理函数
 //
 // 示例：
 //
-//	conf, cleanup, err := config.NewConfig(fileSource, consulSource)
+//	conf, cleanup, err := config.NewConfig(logger, fileSource, consulSource, envSource, secretResolver, audit)
 //	if err != nil {
 //	    log.Fatal(err)
 //	}
 //	defer cleanup()
 func NewConfig(
+	log log.Log,
 	fileSource FileSource,
 	consulSource ConsulSource,
+	envSource EnvSource,
+	secretResolver SecretResolver,
+	audit ConfigAudit,
 ) (Config, func(), error) {
 	var err error
 
-	// 过滤掉 nil 源，只保留有效的配置源
-	var sources = utils.FilterZero([]config.Source{fileSource, consulSource})
+	sources := orderSources(fileSource, consulSource, envSource)
+	log.Infof("config source precedence (low -> high): %s", strings.Join(utils.Map(collectLayers(sources), func(layer SourceLayer) string {
+		return layer.Name
+	}), " < "))
 
-	// 根据环境配置优先级
-	// 本地开发环境：文件配置优先，方便调试
-	// 生产环境：Consul 配置优先，便于集中管理
-	if env.IsLocal() {
-		sources = utils.Reverse(sources)
+	validator, err := newSchemaValidator(rootMessages()...)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	// 创建配置实例并加载配置
-	c := config.New(config.WithSource(NewPriorityConfigSource(sources)))
+	c := config.New(
+		// 合并前按 config.schema.json 校验每个配置文档（见 schema.go），
+		// 再按字段合并策略合并所有配置源后整体替换，不使用 kratos 默认的增量合并（见 merge.go），
+		// 最后与上一次合并的结果对比，记录字段变更（见 audit.go）
+		config.WithSource(withAudit(audit, &sourceLayers{sources},
+			withMerger(newMerger(),
+				withSchemaValidation(log, validator, ConfigSchemaStrict,
+					NewPriorityConfigSource(sources))))),
+		config.WithMergeFunc(replaceMerge),
+		config.WithResolver(secretResolver.Resolve),
+	)
 	err = c.Load()
 	if err != nil {
 		_ = c.Close() // 加载失败时释放配置 watcher 资源
@@ -66,3 +93,25 @@ func NewConfig(
 		_ = c.Close()
 	}, nil
 }
+
+// orderSources 按优先级从低到高排列配置源，并过滤掉 nil 源
+func orderSources(
+	fileSource FileSource,
+	consulSource ConsulSource,
+	envSource EnvSource,
+) []config.Source {
+	var sources = utils.FilterZero([]config.Source{fileSource, consulSource})
+
+	// 根据环境配置优先级
+	// 本地开发环境：文件配置优先，方便调试
+	// 生产环境：Consul 配置优先，便于集中管理
+	if env.IsLocal() {
+		sources = utils.Reverse(sources)
+	}
+
+	// 环境变量优先级最高，放在最后
+	if envSource != nil {
+		sources = append(sources, envSource)
+	}
+	return sources
+}
@@ -0,0 +1,71 @@
+package config
+
+import (
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/jaggerzhuang1994/kratos-foundation/proto/kratos_foundation_pb"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+var (
+	configMessagesLock sync.Mutex
+	// configMessages 配置根消息，默认包含 kratos_foundation_pb.Config
+	configMessages = []protoreflect.MessageDescriptor{
+		(&kratos_foundation_pb.Config{}).ProtoReflect().Descriptor(),
+	}
+	// sectionKeys Section 注册的业务配置段（配置 key 的第一段）
+	sectionKeys []string
+)
+
+// RegisterConfigMessage 注册业务配置的根消息
+//
+// 根消息的描述符用于：
+//   - 环境变量配置源的类型推断
+//   - 多个配置源之间按字段声明的合并策略合并（见 merge.proto）
+//   - 配置文档 schema 校验时识别根节点的业务配置字段（见 schema.go）
+//
+// 需要在 NewConfig 之前调用（例如 main 函数开始或 init 中）。
+//
+// 示例：
+//
+//	config.RegisterConfigMessage(&conf.Bootstrap{})
+func RegisterConfigMessage(messages ...proto.Message) {
+	configMessagesLock.Lock()
+	defer configMessagesLock.Unlock()
+	for _, m := range messages {
+		configMessages = append(configMessages, m.ProtoReflect().Descriptor())
+	}
+}
+
+// RegisterEnvSourceMessage 注册业务配置的根消息，用于环境变量的类型推断
+//
+// Deprecated: 使用 RegisterConfigMessage，同时作用于合并策略
+func RegisterEnvSourceMessage(messages ...proto.Message) {
+	RegisterConfigMessage(messages...)
+}
+
+// registerSectionKey 记录 Section 使用的配置 key，schema 校验时根节点的这些字段不是未知字段
+func registerSectionKey(key string) {
+	configMessagesLock.Lock()
+	defer configMessagesLock.Unlock()
+	root, _, _ := strings.Cut(key, ".")
+	if !slices.Contains(sectionKeys, root) {
+		sectionKeys = append(sectionKeys, root)
+	}
+}
+
+// sectionRoots Section 配置 key 的第一段
+func sectionRoots() []string {
+	configMessagesLock.Lock()
+	defer configMessagesLock.Unlock()
+	return slices.Clone(sectionKeys)
+}
+
+func rootMessages() []protoreflect.MessageDescriptor {
+	configMessagesLock.Lock()
+	defer configMessagesLock.Unlock()
+	return slices.Clone(configMessages)
+}
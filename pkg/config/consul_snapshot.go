@@ -0,0 +1,175 @@
+package config
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/go-kratos/kratos/v2/config"
+	"github.com/jaggerzhuang1994/kratos-foundation/pkg/env"
+	"github.com/jaggerzhuang1994/kratos-foundation/pkg/log"
+	"github.com/pkg/errors"
+)
+
+// ConsulSnapshotDirEnv 指定 consul 配置快照目录的环境变量
+const ConsulSnapshotDirEnv = "CONSUL_CONFIG_SNAPSHOT_DIR"
+
+// ConsulSnapshotDir consul 配置快照目录，为空时不保存快照
+//
+// 默认读取环境变量 CONSUL_CONFIG_SNAPSHOT_DIR，未设置时关闭，需要在 NewConsulSource 之前修改。
+// 快照中是明文配置（包括数据库 DSN、密码等），目录权限为 0700、文件权限为 0600，
+// 开启时应指定只有应用可以访问的目录。
+var ConsulSnapshotDir = env.GetEnv(ConsulSnapshotDirEnv)
+
+// snapshotKeyValue 快照文件中的配置项
+type snapshotKeyValue struct {
+	Key    string `json:"key"`
+	Value  []byte `json:"value"`
+	Format string `json:"format"`
+}
+
+// snapshotSource 带本地快照的配置源
+//
+// 每次从配置源成功加载配置后保存到本地快照文件，
+// 配置源加载失败时使用快照启动，watch 恢复后自动切换回配置源的最新配置。
+type snapshotSource struct {
+	Source
+	name string
+	file string
+	log  log.Log
+
+	mu sync.Mutex
+	// kvs 最新的完整配置
+	kvs []*KeyValue
+
+	fallback      atomic.Bool
+	fallbackTotal atomic.Int64
+}
+
+// withSnapshot 给配置源加上本地快照，dir 为空时返回原配置源
+func withSnapshot(log log.Log, dir, name string, source Source) Source {
+	if dir == "" {
+		return source
+	}
+	return &snapshotSource{
+		Source: source,
+		name:   name,
+		file:   filepath.Join(dir, snapshotFilename(name)),
+		log:    log,
+	}
+}
+
+func (s *snapshotSource) Load() ([]*KeyValue, error) {
+	kvs, err := s.Source.Load()
+	if err == nil {
+		s.recover()
+		s.replace(kvs)
+		return kvs, nil
+	}
+
+	snapshot, snapshotErr := s.readSnapshot()
+	if snapshotErr != nil {
+		return nil, errors.WithMessagef(err, "load %s failed and no snapshot available (%v)", s.name, snapshotErr)
+	}
+	s.fallback.Store(true)
+	s.fallbackTotal.Add(1)
+	s.log.Warnf("load %s failed, fallback to local snapshot %s: %v", s.name, s.file, err)
+
+	s.mu.Lock()
+	s.kvs = snapshot
+	s.mu.Unlock()
+	return snapshot, nil
+}
+
+func (s *snapshotSource) Watch() (config.Watcher, error) {
+	w, err := s.Source.Watch()
+	if err != nil {
+		return nil, err
+	}
+	return &snapshotWatcher{Watcher: w, source: s}, nil
+}
+
+// FallbackState 是否正在使用本地快照，以及累计使用快照的次数
+func (s *snapshotSource) FallbackState() (fallback bool, total int64) {
+	return s.fallback.Load(), s.fallbackTotal.Load()
+}
+
+// recover 配置源恢复，切换回配置源的最新配置
+func (s *snapshotSource) recover() {
+	if s.fallback.CompareAndSwap(true, false) {
+		s.log.Infof("%s recovered, switch back from local snapshot", s.name)
+	}
+}
+
+// replace 使用完整配置替换快照
+func (s *snapshotSource) replace(kvs []*KeyValue) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.kvs = kvs
+	s.writeSnapshot()
+}
+
+func (s *snapshotSource) readSnapshot() ([]*KeyValue, error) {
+	data, err := os.ReadFile(s.file)
+	if err != nil {
+		return nil, err
+	}
+	var items []snapshotKeyValue
+	if err = json.Unmarshal(data, &items); err != nil {
+		return nil, err
+	}
+	kvs := make([]*KeyValue, 0, len(items))
+	for _, item := range items {
+		kvs = append(kvs, &KeyValue{Key: item.Key, Value: item.Value, Format: item.Format})
+	}
+	return kvs, nil
+}
+
+// writeSnapshot 写入快照文件，先写临时文件再重命名，保证快照文件完整
+func (s *snapshotSource) writeSnapshot() {
+	items := make([]snapshotKeyValue, 0, len(s.kvs))
+	for _, kv := range s.kvs {
+		items = append(items, snapshotKeyValue{Key: kv.Key, Value: kv.Value, Format: kv.Format})
+	}
+	data, err := json.Marshal(items)
+	if err != nil {
+		s.log.Warnf("save snapshot of %s failed: %v", s.name, err)
+		return
+	}
+	if err = os.MkdirAll(filepath.Dir(s.file), 0700); err != nil {
+		s.log.Warnf("save snapshot of %s failed: %v", s.name, err)
+		return
+	}
+	tmp := s.file + ".tmp"
+	if err = os.WriteFile(tmp, data, 0600); err != nil {
+		s.log.Warnf("save snapshot of %s failed: %v", s.name, err)
+		return
+	}
+	if err = os.Rename(tmp, s.file); err != nil {
+		s.log.Warnf("save snapshot of %s failed: %v", s.name, err)
+	}
+}
+
+type snapshotWatcher struct {
+	config.Watcher
+	source *snapshotSource
+}
+
+func (w *snapshotWatcher) Next() ([]*KeyValue, error) {
+	kvs, err := w.Watcher.Next()
+	if err != nil {
+		return nil, err
+	}
+	// watch 有数据返回说明配置源已经恢复
+	w.source.recover()
+	w.source.replace(kvs)
+	return kvs, nil
+}
+
+// snapshotFilename 将配置源名称转换为文件名
+func snapshotFilename(name string) string {
+	return strings.NewReplacer("/", "_", ":", "_", "\\", "_").Replace(strings.Trim(name, "/")) + ".json"
+}
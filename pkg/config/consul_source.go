@@ -1,8 +1,13 @@
 package config
 
 import (
+	"strings"
+	"sync"
+
 	config "github.com/go-kratos/kratos/contrib/config/consul/v2"
+	kconfig "github.com/go-kratos/kratos/v2/config"
 	"github.com/jaggerzhuang1994/kratos-foundation/pkg/consul"
+	"github.com/jaggerzhuang1994/kratos-foundation/pkg/env"
 	"github.com/jaggerzhuang1994/kratos-foundation/pkg/log"
 	"github.com/jaggerzhuang1994/kratos-foundation/pkg/utils"
 )
@@ -29,6 +34,14 @@ type ConsulSourcePathList []string
 // 配置合并规则：
 //   - 多个配置路径会按顺序合并，后加载的配置会覆盖前面的配置
 //   - 例如：["config/base", "config/prod"]，prod 中的配置会覆盖 base 中的同名配置
+//   - 每个路径自动追加当前环境的覆盖路径 {path}.{env}，例如 config/app => config/app.prod，
+//     覆盖路径排在所有基础路径之后（见 profile.go）
+//
+// 本地快照：
+//   - 设置了 ConsulSnapshotDir（默认关闭）时，每次成功加载后保存到该目录下的快照文件
+//   - 加载配置失败（例如读取 KV 超时）则使用快照启动（记录警告日志和 config_source_fallback 指标）；
+//     consul 客户端初始化时无法访问 leader 仍然启动失败，避免影响服务注册和发现
+//   - consul 恢复后 watch 会拉取到最新配置，自动切换回 consul 配置
 //
 // 失败场景：
 //   - consulSourcePathList 为空：记录警告日志，返回 nil
@@ -40,7 +53,7 @@ type ConsulSourcePathList []string
 //	consulSource := config.NewConsulSource(
 //	    consulClient,
 //	    logger,
-//	    config.ConsulSourcePathList{"/config/app/base", "/config/app/prod"},
+//	    config.ConsulSourcePathList{"/config/app"}, // prod 环境加载 /config/app 和 /config/app.prod
 //	)
 //
 //	// 使用配置源
@@ -62,13 +75,66 @@ func NewConsulSource(
 		return nil
 	}
 
-	log.Info("consul source path list:", consulSourcePathList)
+	// 追加当前环境的覆盖路径 {path}.{env}
+	paths := consulProfilePaths(env.AppEnv(), consulSourcePathList...)
+	log.Infof("consul source profile %s, precedence (low -> high): %s", env.AppEnv(), strings.Join(paths, " < "))
 
 	// 将多个配置路径转换为配置源列表
 	// 每个路径都会创建一个独立的配置源
 	// 所有配置源会被包装成优先级配置源，后面的配置会覆盖前面的配置
-	return NewPriorityConfigSource(utils.Map(consulSourcePathList, func(configPath string) Source {
-		sc, _ := config.New(client, config.WithPath(configPath))
-		return sc
+	return NewPriorityConfigSource(utils.Map(paths, func(configPath string) Source {
+		name := "consul:" + configPath
+		sc, _ := config.New(client, config.WithPath(consulKeyPrefix(configPath)))
+		return withSourceName(name, withSnapshot(log, ConsulSnapshotDir, name, withFullKeyValues(sc)))
 	}))
 }
+
+// fullKeyValueSource 将 consul watch 返回的变更配置项合并为全部配置项
+//
+// kratos consul watcher 只返回 ModifyIndex 发生变化的 key，
+// 而配置合并（见 mergedSource）需要每个配置源的全部配置项
+type fullKeyValueSource struct {
+	Source
+
+	mu  sync.Mutex
+	kvs []*KeyValue
+}
+
+func withFullKeyValues(source Source) Source {
+	return &fullKeyValueSource{Source: source}
+}
+
+func (s *fullKeyValueSource) Load() ([]*KeyValue, error) {
+	kvs, err := s.Source.Load()
+	if err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.kvs = kvs
+	return kvs, nil
+}
+
+func (s *fullKeyValueSource) Watch() (kconfig.Watcher, error) {
+	w, err := s.Source.Watch()
+	if err != nil {
+		return nil, err
+	}
+	return &fullKeyValueWatcher{Watcher: w, source: s}, nil
+}
+
+type fullKeyValueWatcher struct {
+	kconfig.Watcher
+	source *fullKeyValueSource
+}
+
+func (w *fullKeyValueWatcher) Next() ([]*KeyValue, error) {
+	changed, err := w.Watcher.Next()
+	if err != nil {
+		return nil, err
+	}
+	w.source.mu.Lock()
+	defer w.source.mu.Unlock()
+	w.source.kvs = mergeKeyValues(w.source.kvs, changed)
+	return w.source.kvs, nil
+}
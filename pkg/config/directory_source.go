@@ -0,0 +1,235 @@
+package config
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/go-kratos/kratos/v2/config"
+	"github.com/go-kratos/kratos/v2/encoding"
+	"github.com/jaggerzhuang1994/kratos-foundation/pkg/env"
+	"github.com/pkg/errors"
+)
+
+// directoryWatchDebounce 目录变更后等待的时间，合并同一次更新产生的多个事件
+const directoryWatchDebounce = 100 * time.Millisecond
+
+// directoryWatchRetries 加载失败（例如软链接替换到一半）后不等待新的事件、重新加载的次数
+const directoryWatchRetries = 3
+
+// directoryDataLink kubernetes 挂载目录中指向当前版本的软链接
+const directoryDataLink = "..data"
+
+// directorySource 目录配置源
+//
+// 与 kratos file.NewSource 不同，watch 监听的是目录本身而不是文件：
+// kubernetes 挂载 ConfigMap/Secret 时，目录下的文件是指向 ..data/{name} 的软链接，
+// 更新时创建新的时间戳目录，再把 ..data 原子替换为指向新目录的软链接，文件本身不会产生事件。
+// 监听目录后，任何变更都会重新加载目录，只有内容发生变化时才返回。
+type directorySource struct {
+	dir string
+	// file 不为空时只加载目录下的这一个文件
+	file string
+}
+
+// NewDirectorySource 创建目录配置源，用于加载 kubernetes ConfigMap/Secret 挂载的目录
+//
+// 加载规则：
+//   - 加载目录下的所有文件（跟随软链接），忽略子目录和隐藏文件（..data 等）
+//   - 文件按环境 profile 排序：config.yaml < config.{env}.yaml < config.local.yaml，
+//     其他环境的 profile 文件不加载（见 profile.go）
+//   - 目录中新增、删除配置文件和 ..data 软链接替换都会触发重新加载，
+//     其他文件（隐藏文件、日志等扩展名不是配置格式的文件）的事件忽略
+//
+// 示例：
+//
+//	source := config.NewDirectorySource("/etc/config")
+func NewDirectorySource(dir string) Source {
+	return &directorySource{dir: dir}
+}
+
+// newWatchedFileSource 创建文件配置源，通过监听文件所在目录感知软链接替换
+// 目录中只有这个文件和 ..data 的事件触发重新加载
+func newWatchedFileSource(filename string) Source {
+	return &directorySource{dir: filepath.Dir(filename), file: filepath.Base(filename)}
+}
+
+func (s *directorySource) Load() ([]*KeyValue, error) {
+	var names []string
+	if s.file != "" {
+		names = []string{s.file}
+	} else {
+		var err error
+		names, _, err = directoryFiles(env.AppEnv(), s.dir)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	kvs := make([]*KeyValue, 0, len(names))
+	for _, name := range names {
+		data, err := os.ReadFile(filepath.Join(s.dir, name))
+		if err != nil {
+			return nil, errors.WithMessagef(err, "load %s failed", filepath.Join(s.dir, name))
+		}
+		kvs = append(kvs, &KeyValue{
+			Key:    name,
+			Value:  data,
+			Format: strings.TrimPrefix(filepath.Ext(name), "."),
+		})
+	}
+	return kvs, nil
+}
+
+func (s *directorySource) Watch() (config.Watcher, error) {
+	fw, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, err
+	}
+	if err = fw.Add(s.dir); err != nil {
+		_ = fw.Close()
+		return nil, errors.WithMessagef(err, "watch %s failed", s.dir)
+	}
+	// 记录当前内容，用于判断目录事件是否真的改变了配置
+	last, _ := s.Load()
+	ctx, cancel := context.WithCancel(context.Background())
+	return &directoryWatcher{
+		source: s,
+		fw:     fw,
+		last:   last,
+		ctx:    ctx,
+		cancel: cancel,
+	}, nil
+}
+
+// relevant 目录事件是否可能改变加载的配置
+// 同一目录下的其他文件（例如默认的 ./app.log）持续写入时，事件不能重置 debounce
+func (s *directorySource) relevant(event fsnotify.Event) bool {
+	name := filepath.Base(event.Name)
+	if name == directoryDataLink {
+		return true
+	}
+	if s.file != "" {
+		return name == s.file
+	}
+	return !strings.HasPrefix(name, ".") && encoding.GetCodec(strings.TrimPrefix(filepath.Ext(name), ".")) != nil
+}
+
+// directoryFiles 返回目录下需要加载的文件名，按 profile 优先级从低到高排列
+func directoryFiles(appEnv, dir string) (files, skipped []string, err error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, nil, errors.WithMessagef(err, "read dir %s failed", dir)
+	}
+	var names []string
+	for _, entry := range entries {
+		if strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		// 跟随软链接判断是否为文件
+		fi, err := os.Stat(filepath.Join(dir, entry.Name()))
+		if err != nil || fi.IsDir() {
+			continue
+		}
+		names = append(names, entry.Name())
+	}
+	files, skipped = sortByProfile(appEnv, names)
+	return files, skipped, nil
+}
+
+type directoryWatcher struct {
+	source *directorySource
+	fw     *fsnotify.Watcher
+	last   []*KeyValue
+	// retries 连续加载失败的次数，不为 0 时下一次 wait 不等待新的事件
+	retries int
+
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+// Next 等待目录变更，返回变更后的全部配置项
+func (w *directoryWatcher) Next() ([]*KeyValue, error) {
+	for {
+		if err := w.wait(w.retries > 0); err != nil {
+			return nil, err
+		}
+		kvs, err := w.source.Load()
+		if err != nil {
+			// 软链接替换到一半时文件可能暂时不存在，稍后重新加载，不等待下一次事件
+			if w.retries < directoryWatchRetries {
+				w.retries++
+				continue
+			}
+			w.retries = 0
+			return nil, err
+		}
+		w.retries = 0
+		if equalKeyValues(w.last, kvs) {
+			continue
+		}
+		w.last = kvs
+		return kvs, nil
+	}
+}
+
+// wait 等待目录事件，收到事件后继续等待 directoryWatchDebounce，合并同一次更新的多个事件
+// 与配置无关的事件忽略；retry 为 true 时不等待新的事件，只等待 directoryWatchDebounce
+func (w *directoryWatcher) wait(retry bool) error {
+	triggered := retry
+	for !triggered {
+		select {
+		case <-w.ctx.Done():
+			return w.ctx.Err()
+		case err, ok := <-w.fw.Errors:
+			if !ok {
+				return context.Canceled
+			}
+			return err
+		case event, ok := <-w.fw.Events:
+			if !ok {
+				return context.Canceled
+			}
+			triggered = w.source.relevant(event)
+		}
+	}
+
+	timer := time.NewTimer(directoryWatchDebounce)
+	defer timer.Stop()
+	for {
+		select {
+		case <-w.ctx.Done():
+			return w.ctx.Err()
+		case err, ok := <-w.fw.Errors:
+			if !ok {
+				return context.Canceled
+			}
+			return err
+		case event, ok := <-w.fw.Events:
+			if !ok {
+				return context.Canceled
+			}
+			if w.source.relevant(event) {
+				timer.Reset(directoryWatchDebounce)
+			}
+		case <-timer.C:
+			return nil
+		}
+	}
+}
+
+func (w *directoryWatcher) Stop() error {
+	w.cancel()
+	return w.fw.Close()
+}
+
+func equalKeyValues(a, b []*KeyValue) bool {
+	return slices.EqualFunc(a, b, func(x, y *KeyValue) bool {
+		return x.Key == y.Key && x.Format == y.Format && bytes.Equal(x.Value, y.Value)
+	})
+}
@@ -0,0 +1,101 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestWatchedFileSourceIgnoresOtherFiles(t *testing.T) {
+	dir := t.TempDir()
+	filename := filepath.Join(dir, "config.yaml")
+	if err := os.WriteFile(filename, []byte("app:\n  name: a\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	w, err := newWatchedFileSource(filename).Watch()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = w.Stop() })
+
+	// 同一目录下持续写入的日志文件不能让 debounce 一直重置
+	stop := make(chan struct{})
+	done := make(chan struct{})
+	t.Cleanup(func() {
+		close(stop)
+		<-done
+	})
+	go func() {
+		defer close(done)
+		f, err := os.Create(filepath.Join(dir, "app.log"))
+		if err != nil {
+			return
+		}
+		defer f.Close()
+		for {
+			select {
+			case <-stop:
+				return
+			case <-time.After(10 * time.Millisecond):
+				_, _ = f.WriteString("log\n")
+			}
+		}
+	}()
+
+	if err = os.WriteFile(filename, []byte("app:\n  name: b\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	result := make(chan []*KeyValue, 1)
+	go func() {
+		kvs, _ := w.Next()
+		result <- kvs
+	}()
+	select {
+	case kvs := <-result:
+		if len(kvs) != 1 || string(kvs[0].Value) != "app:\n  name: b\n" {
+			t.Errorf("kvs = %v", kvs)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("config change was not reloaded while another file in the directory kept changing")
+	}
+}
+
+func TestWatchedFileSourceRetryAfterLoadFailure(t *testing.T) {
+	dir := t.TempDir()
+	filename := filepath.Join(dir, "config.yaml")
+	if err := os.WriteFile(filename, []byte("app:\n  name: a\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	w, err := newWatchedFileSource(filename).Watch()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = w.Stop() })
+
+	result := make(chan []*KeyValue, 1)
+	go func() {
+		kvs, _ := w.Next()
+		result <- kvs
+	}()
+	// 替换到一半：软链接指向的文件稍后才写入，写入发生在监听的目录之外，不会产生事件
+	target := filepath.Join(t.TempDir(), "config.yaml")
+	if err = os.Remove(filename); err != nil {
+		t.Fatal(err)
+	}
+	if err = os.Symlink(target, filename); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(directoryWatchDebounce * 3 / 2)
+	if err = os.WriteFile(target, []byte("app:\n  name: b\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case kvs := <-result:
+		if len(kvs) != 1 || string(kvs[0].Value) != "app:\n  name: b\n" {
+			t.Errorf("kvs = %v", kvs)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("config change was lost after a failed load")
+	}
+}
@@ -0,0 +1,241 @@
+package config
+
+import (
+	"encoding/json"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/config"
+	"github.com/go-kratos/kratos/v2/config/env"
+	"github.com/jaggerzhuang1994/kratos-foundation/pkg/log"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// EnvSource 是环境变量配置源的别名
+// 用于通过环境变量覆盖配置，优先级最高
+type EnvSource Source
+
+// EnvSourcePrefix 环境变量前缀，只有以此前缀开头的环境变量才会被当作配置
+var EnvSourcePrefix = "KF_"
+
+// envPathSeparator 环境变量中表示配置层级的分隔符
+const envPathSeparator = "__"
+
+// NewEnvSource 创建环境变量配置源
+//
+// 环境变量映射规则：
+//   - 去掉 EnvSourcePrefix 前缀后，按 "__" 拆分为配置路径，并转换为小写
+//   - 例如 KF_DATABASE__CONNECTIONS__MAIN__DSN => database.connections.main.dsn
+//   - 例如 KF_SERVER__HTTP__ADDR => server.http.addr
+//
+// 类型推断：
+//   - 根据 proto 描述符将字符串转换为对应类型（bool、数字、枚举、Duration 等）
+//   - repeated 字段支持 JSON 数组或逗号分隔的值
+//   - map/message 字段可以直接使用 JSON 对象，也可以继续用 "__" 指定子字段
+//   - 找不到描述符的路径按字符串处理
+//   - 业务配置需要通过 RegisterConfigMessage 注册根消息
+//
+// 注意事项：
+//   - 环境变量名不区分大小写，map 的 key 会被转换为小写
+//   - 没有匹配的环境变量时返回 nil
+func NewEnvSource(log log.Log) (EnvSource, error) {
+	kv, err := loadEnv(os.Environ())
+	if err != nil {
+		return nil, errors.WithMessage(err, "load env source failed")
+	}
+	if kv == nil {
+		return nil, nil
+	}
+	log.Info("env source loaded, prefix: ", EnvSourcePrefix)
+	return &envSource{kv}, nil
+}
+
+type envSource struct {
+	kv *config.KeyValue
+}
+
+func (s *envSource) Load() ([]*config.KeyValue, error) {
+	return []*config.KeyValue{s.kv}, nil
+}
+
+func (s *envSource) Layers() []SourceLayer {
+	return []SourceLayer{{Name: "env", KeyValues: []*config.KeyValue{s.kv}}}
+}
+
+func (s *envSource) Watch() (config.Watcher, error) {
+	// 进程运行期间环境变量不会变化
+	return env.NewWatcher()
+}
+
+func loadEnv(environ []string) (*config.KeyValue, error) {
+	roots := rootMessages()
+
+	values := map[string]any{}
+	for _, e := range environ {
+		k, v, ok := strings.Cut(e, "=")
+		if !ok || !strings.HasPrefix(k, EnvSourcePrefix) {
+			continue
+		}
+		path := strings.Split(strings.ToLower(strings.TrimPrefix(k, EnvSourcePrefix)), envPathSeparator)
+		if len(path) == 0 || path[0] == "" {
+			continue
+		}
+		value, err := coerceEnvValue(roots, path, v)
+		if err != nil {
+			return nil, errors.WithMessagef(err, "env %s", k)
+		}
+		setEnvValue(values, path, value)
+	}
+	if len(values) == 0 {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(values)
+	if err != nil {
+		return nil, err
+	}
+	return &config.KeyValue{
+		Key:    "env",
+		Value:  data,
+		Format: JsonFormat,
+	}, nil
+}
+
+// setEnvValue 按路径写入嵌套 map
+func setEnvValue(values map[string]any, path []string, value any) {
+	for _, p := range path[:len(path)-1] {
+		next, ok := values[p].(map[string]any)
+		if !ok {
+			next = map[string]any{}
+			values[p] = next
+		}
+		values = next
+	}
+	values[path[len(path)-1]] = value
+}
+
+// coerceEnvValue 在所有根消息中查找路径对应的字段，并转换类型
+func coerceEnvValue(roots []protoreflect.MessageDescriptor, path []string, raw string) (any, error) {
+	for _, root := range roots {
+		fd, mapValue, ok := lookupEnvField(root, path)
+		if !ok {
+			continue
+		}
+		return coerceField(fd, mapValue, raw)
+	}
+	return raw, nil
+}
+
+// lookupEnvField 根据路径查找字段描述符
+//
+// mapValue 表示路径最后一段是 map 的 key，此时需要按 map value 的类型转换
+func lookupEnvField(md protoreflect.MessageDescriptor, path []string) (fd protoreflect.FieldDescriptor, mapValue bool, ok bool) {
+	for i := 0; i < len(path); i++ {
+		if md == nil {
+			return nil, false, false
+		}
+		fd = md.Fields().ByName(protoreflect.Name(path[i]))
+		if fd == nil {
+			return nil, false, false
+		}
+		last := i == len(path)-1
+		if last {
+			return fd, false, true
+		}
+		if fd.IsMap() {
+			// 下一段是 map 的 key
+			i++
+			if i == len(path)-1 {
+				return fd, true, true
+			}
+			md = fd.MapValue().Message()
+			continue
+		}
+		if fd.IsList() {
+			// repeated 字段只能整体覆盖
+			return nil, false, false
+		}
+		md = fd.Message()
+	}
+	return nil, false, false
+}
+
+func coerceField(fd protoreflect.FieldDescriptor, mapValue bool, raw string) (any, error) {
+	if mapValue {
+		return coerceSingular(fd.MapValue(), raw)
+	}
+	if fd.IsMap() {
+		return decodeJSON(raw)
+	}
+	if fd.IsList() {
+		if strings.HasPrefix(strings.TrimSpace(raw), "[") {
+			return decodeJSON(raw)
+		}
+		var list []any
+		for _, item := range strings.Split(raw, ",") {
+			v, err := coerceSingular(fd, strings.TrimSpace(item))
+			if err != nil {
+				return nil, err
+			}
+			list = append(list, v)
+		}
+		return list, nil
+	}
+	return coerceSingular(fd, raw)
+}
+
+func coerceSingular(fd protoreflect.FieldDescriptor, raw string) (any, error) {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return strconv.ParseBool(raw)
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return strconv.ParseInt(raw, 10, 32)
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return strconv.ParseUint(raw, 10, 32)
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		// 64 位整数使用字符串，避免 json 解析为 float64 丢失精度（protojson 支持字符串形式）
+		_, err := strconv.ParseInt(raw, 10, 64)
+		return raw, err
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		_, err := strconv.ParseUint(raw, 10, 64)
+		return raw, err
+	case protoreflect.FloatKind:
+		return strconv.ParseFloat(raw, 32)
+	case protoreflect.DoubleKind:
+		return strconv.ParseFloat(raw, 64)
+	case protoreflect.EnumKind:
+		// 支持枚举名（不区分大小写）或数字
+		if n, err := strconv.ParseInt(raw, 10, 32); err == nil {
+			return n, nil
+		}
+		values := fd.Enum().Values()
+		for i := 0; i < values.Len(); i++ {
+			if strings.EqualFold(string(values.Get(i).Name()), raw) {
+				return string(values.Get(i).Name()), nil
+			}
+		}
+		return nil, errors.Errorf("invalid enum value %q for %s", raw, fd.Enum().FullName())
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		if md := fd.Message(); md.FullName().Parent() == "google.protobuf" && !strings.HasPrefix(strings.TrimSpace(raw), "{") {
+			// BoolValue、Int64Value 等包装类型按内部 value 字段转换
+			if md.Fields().Len() == 1 && md.Fields().ByName("value") != nil {
+				return coerceSingular(md.Fields().ByName("value"), raw)
+			}
+			// Duration、Timestamp 等 well-known type 在 protojson 中就是字符串
+			return raw, nil
+		}
+		return decodeJSON(raw)
+	default:
+		return raw, nil
+	}
+}
+
+func decodeJSON(raw string) (any, error) {
+	var v any
+	if err := json.Unmarshal([]byte(raw), &v); err != nil {
+		return nil, errors.WithMessage(err, "invalid json")
+	}
+	return v, nil
+}
@@ -1,10 +1,12 @@
 package config
 
 import (
+	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/go-kratos/kratos/v2/config"
-	"github.com/go-kratos/kratos/v2/config/file"
+	"github.com/jaggerzhuang1994/kratos-foundation/pkg/env"
 	"github.com/jaggerzhuang1994/kratos-foundation/pkg/log"
 	"github.com/jaggerzhuang1994/kratos-foundation/pkg/utils"
 	"github.com/pkg/errors"
@@ -21,14 +23,24 @@ func NewFileSource(
 		log.Warn("file source not loaded: config path is empty")
 		return nil, nil
 	}
-	matches, err := glob(fileSourcePathList...)
+	// 按 config.yaml < config.{env}.yaml < config.local.yaml 展开文件列表（见 profile.go）
+	matches, skipped, err := expandFileProfiles(env.AppEnv(), fileSourcePathList...)
 	if err != nil {
 		return nil, errors.WithMessage(err, "load file source failed")
 	}
-	log.Info("file config list:", matches)
-	// 通配符返回的文件列表构成一个优先级组，优先级按照glob返回的顺序
+	log.Infof("file config profile %s, precedence (low -> high): %s", env.AppEnv(), strings.Join(matches, " < "))
+	if len(skipped) > 0 {
+		log.Info("file config of other profiles skipped:", skipped)
+	}
+	// 展开后的文件列表构成一个优先级组，后面的文件覆盖前面的文件
+	// 目录和文件都监听所在目录，能感知 kubernetes ConfigMap 挂载的软链接替换（见 directory_source.go）
 	return NewPriorityConfigSource(utils.Map(matches, func(filename string) config.Source {
-		return file.NewSource(filename)
+		if fi, err := os.Stat(filename); err == nil && fi.IsDir() {
+			files, skipped, _ := directoryFiles(env.AppEnv(), filename)
+			log.Infof("directory config %s, precedence (low -> high): %s, skipped: %v", filename, strings.Join(files, " < "), skipped)
+			return withSourceName(filename, NewDirectorySource(filename))
+		}
+		return withSourceName(filename, newWatchedFileSource(filename))
 	})), nil
 }
 
@@ -0,0 +1,159 @@
+package config
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	log2 "github.com/jaggerzhuang1994/kratos-foundation/pkg/log"
+	"google.golang.org/protobuf/proto"
+)
+
+// configServer 模拟配置服务，支持 ETag 和 SHA-256 校验和
+type configServer struct {
+	mu       sync.Mutex
+	body     string
+	checksum string // 为空时使用 body 的校验和
+	fail     bool
+
+	requests    atomic.Int64
+	notModified atomic.Int64
+}
+
+func (s *configServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.requests.Add(1)
+	s.mu.Lock()
+	body, checksum, fail := s.body, s.checksum, s.fail
+	s.mu.Unlock()
+
+	if fail {
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+		return
+	}
+	sum := sha256.Sum256([]byte(body))
+	etag := `"` + hex.EncodeToString(sum[:8]) + `"`
+	if r.Header.Get("If-None-Match") == etag {
+		s.notModified.Add(1)
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	if checksum == "" {
+		checksum = hex.EncodeToString(sum[:])
+	}
+	w.Header().Set("ETag", etag)
+	w.Header().Set(HttpSourceChecksumHeader, checksum)
+	w.Header().Set("Content-Type", "application/yaml")
+	_, _ = w.Write([]byte(body))
+}
+
+func newTestHttpSource(t *testing.T, cs *configServer, opts ...HttpSourceOption) Source {
+	t.Helper()
+	srv := httptest.NewServer(cs)
+	t.Cleanup(srv.Close)
+	// 测试中不输出日志文件
+	logConfig := log2.NewDefaultConfig()
+	logConfig.File.Disable = proto.Bool(true)
+	logger, _, err := log2.NewLogger(log2.PresetKv{}, logConfig, log2.NewHook(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return NewHttpSource(log2.NewLog(logger), srv.URL+"/apps/demo/config", opts...)
+}
+
+func TestHttpSourceLoad(t *testing.T) {
+	cs := &configServer{body: "server:\n  http:\n    addr: 0.0.0.0:8000\n"}
+	source := newTestHttpSource(t, cs)
+
+	kvs, err := source.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(kvs) != 1 || kvs[0].Key != "config" || kvs[0].Format != YamlFormat || string(kvs[0].Value) != cs.body {
+		t.Fatalf("kvs = %+v", kvs[0])
+	}
+	if layers := source.(layeredSource).Layers(); len(layers) != 1 || layers[0].Name == "" {
+		t.Errorf("layers = %+v", layers)
+	}
+}
+
+func TestHttpSourceChecksumMismatch(t *testing.T) {
+	cs := &configServer{body: "a: 1\n", checksum: "0000"}
+	if _, err := newTestHttpSource(t, cs).Load(); err == nil {
+		t.Fatal("load with mismatched checksum should fail")
+	}
+}
+
+func TestHttpSourceWatch(t *testing.T) {
+	cs := &configServer{body: "a: 1\n"}
+	source := newTestHttpSource(t, cs, WithHttpSourceInterval(10*time.Millisecond))
+	if _, err := source.Load(); err != nil {
+		t.Fatal(err)
+	}
+	w, err := source.Watch()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = w.Stop() }()
+
+	type result struct {
+		kvs []*KeyValue
+		err error
+	}
+	next := make(chan result, 1)
+	go func() {
+		kvs, err := w.Next()
+		next <- result{kvs, err}
+	}()
+
+	// 没有变化时使用条件请求，Next 不返回
+	waitFor(t, func() bool { return cs.notModified.Load() >= 2 })
+
+	// 服务不可用和校验失败时继续使用缓存，Next 不返回
+	cs.mu.Lock()
+	cs.fail = true
+	cs.mu.Unlock()
+	requests := cs.requests.Load()
+	waitFor(t, func() bool { return cs.requests.Load() >= requests+2 })
+	cs.mu.Lock()
+	cs.fail, cs.body, cs.checksum = false, "a: 2\n", "0000"
+	cs.mu.Unlock()
+	requests = cs.requests.Load()
+	waitFor(t, func() bool { return cs.requests.Load() >= requests+2 })
+	select {
+	case r := <-next:
+		t.Fatalf("Next returned before config changed: %+v, %v", r.kvs, r.err)
+	default:
+	}
+	if kvs, err := source.Load(); err != nil || string(kvs[0].Value) != "a: 1\n" {
+		t.Fatalf("Load should fall back to cached config, got %v, %v", kvs, err)
+	}
+
+	// 内容变化且校验通过后返回新的配置
+	cs.mu.Lock()
+	cs.checksum = ""
+	cs.mu.Unlock()
+	select {
+	case r := <-next:
+		if r.err != nil || len(r.kvs) != 1 || string(r.kvs[0].Value) != "a: 2\n" {
+			t.Fatalf("Next = %+v, %v", r.kvs, r.err)
+		}
+	case <-time.After(3 * time.Second):
+		t.Fatal("Next did not return after config changed")
+	}
+}
+
+func waitFor(t *testing.T, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(3 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatal("condition not met")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
@@ -8,8 +8,13 @@ import (
 
 type KratosFoundationConfig = *kratos_foundation_pb.Config
 
+// NewKratosFoundationConfig 加载框架配置
+//
+// 加载后会立即执行 ValidateKratosFoundationConfig，校验失败时返回包含所有错误字段路径的汇总报告，
+// 此时还没有创建数据库、redis、客户端和服务端，服务会直接启动失败（fail-fast）
 func NewKratosFoundationConfig(
 	config Config,
+	defaults DefaultKratosFoundationConfig,
 	logger log.UpdateLogger,
 ) (KratosFoundationConfig, error) {
 	var c kratos_foundation_pb.Config
@@ -17,6 +22,10 @@ func NewKratosFoundationConfig(
 	if err != nil {
 		return nil, errors.WithMessage(err, "load KratosFoundationConfig failed")
 	}
+	err = ValidateKratosFoundationConfig(&c, defaults)
+	if err != nil {
+		return nil, err
+	}
 	err = logger.Update(c.GetLog())
 	if err != nil {
 		return nil, errors.WithMessage(err, "init log failed")
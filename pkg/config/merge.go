@@ -0,0 +1,371 @@
+package config
+
+import (
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/config"
+	"github.com/go-kratos/kratos/v2/encoding"
+	"github.com/jaggerzhuang1994/kratos-foundation/proto/kratos_foundation_pb/config_pb"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// mergedKey 合并后的配置项名称
+const mergedKey = "merged"
+
+// merger 多个配置源之间的确定性合并
+//
+// kratos 默认的合并（mergo）在每次 watch 时把变更的配置源合并到上一次的结果上，
+// 列表整体替换、零值不能覆盖、配置源删除的字段不会消失，结果取决于变更的顺序。
+// merger 每次都按优先级从低到高重新合并所有配置源，字段的合并策略由 proto 字段选项
+// (kratos_foundation_pb.merge) 声明：
+//   - REPLACE：高优先级的值整体替换低优先级的值（repeated 字段默认）
+//   - APPEND：repeated 字段依次追加，去掉重复的元素
+//   - MERGE_BY_KEY：repeated message 按 keys 匹配元素后递归合并；map 按 key 递归合并（map 默认）
+//
+// 其他规则：
+//   - message 字段按字段递归合并，配置文件中的字段名可以是 proto 名或 json 名
+//   - 高优先级配置源中值为 null（例如 yaml 中只写了 key:）的字段不会覆盖低优先级的值
+//   - false、0、空字符串等零值会正常覆盖
+//   - 找不到描述符的字段按 map 递归合并，其他值覆盖
+type merger struct {
+	roots []protoreflect.MessageDescriptor
+}
+
+// newMerger 根据配置根消息创建 merger，roots 为空时使用 RegisterConfigMessage 注册的根消息
+func newMerger(roots ...protoreflect.MessageDescriptor) *merger {
+	if len(roots) == 0 {
+		roots = rootMessages()
+	}
+	return &merger{roots: roots}
+}
+
+// Merge 按优先级从低到高合并，不会修改参数
+func (m *merger) Merge(layers ...map[string]any) map[string]any {
+	merged := map[string]any{}
+	for _, layer := range layers {
+		m.mergeMessage(m.roots, merged, layer)
+	}
+	return merged
+}
+
+// mergeMessage 将 src 合并到 dst，mds 为当前层级对应的 message（根节点可能有多个）
+func (m *merger) mergeMessage(mds []protoreflect.MessageDescriptor, dst, src map[string]any) {
+	for _, k := range slices.Sorted(maps.Keys(src)) {
+		sv := src[k]
+		if sv == nil {
+			continue
+		}
+		fd := lookupMergeField(mds, k)
+		if fd == nil {
+			dst[k] = mergeUntyped(dst[k], sv)
+			continue
+		}
+		// 同一个字段可能使用 proto 名或 json 名，合并到已存在的 key 上，避免 Scan 时字段重复
+		dk := k
+		if _, ok := dst[k]; !ok {
+			if alias, ok := lookupFieldKey(dst, fd); ok {
+				dk = alias
+			}
+		}
+		dst[dk] = m.mergeField(fd, dst[dk], sv)
+	}
+}
+
+func (m *merger) mergeField(fd protoreflect.FieldDescriptor, dv, sv any) any {
+	rule := proto.GetExtension(fd.Options(), config_pb.E_Merge).(*config_pb.MergeRule)
+	strategy := rule.GetStrategy()
+	if strategy == config_pb.MergeStrategy_MERGE_STRATEGY_REPLACE || dv == nil {
+		return cloneValue(sv)
+	}
+
+	switch {
+	case fd.IsMap():
+		dm, dok := dv.(map[string]any)
+		sm, sok := sv.(map[string]any)
+		if !dok || !sok {
+			return cloneValue(sv)
+		}
+		md := fd.MapValue().Message()
+		for k, v := range sm {
+			if v == nil {
+				continue
+			}
+			dItem, dItemOk := dm[k].(map[string]any)
+			sItem, sItemOk := v.(map[string]any)
+			if md == nil || !dItemOk || !sItemOk {
+				dm[k] = cloneValue(v)
+				continue
+			}
+			m.mergeMessage([]protoreflect.MessageDescriptor{md}, dItem, sItem)
+		}
+		return dm
+	case fd.IsList():
+		dl, dok := dv.([]any)
+		sl, sok := sv.([]any)
+		if !dok || !sok {
+			return cloneValue(sv)
+		}
+		switch strategy {
+		case config_pb.MergeStrategy_MERGE_STRATEGY_APPEND:
+			for _, item := range sl {
+				if !slices.ContainsFunc(dl, func(v any) bool { return reflect.DeepEqual(v, item) }) {
+					dl = append(dl, cloneValue(item))
+				}
+			}
+			return dl
+		case config_pb.MergeStrategy_MERGE_STRATEGY_MERGE_BY_KEY:
+			if fd.Message() == nil || len(rule.GetKeys()) == 0 {
+				return cloneValue(sv)
+			}
+			return m.mergeByKey(fd.Message(), rule.GetKeys(), dl, sl)
+		default:
+			return cloneValue(sv)
+		}
+	case fd.Message() != nil:
+		dm, dok := dv.(map[string]any)
+		sm, sok := sv.(map[string]any)
+		if !dok || !sok {
+			// Duration 等 well-known type 在配置文件中是字符串
+			return cloneValue(sv)
+		}
+		m.mergeMessage([]protoreflect.MessageDescriptor{fd.Message()}, dm, sm)
+		return dm
+	default:
+		return cloneValue(sv)
+	}
+}
+
+// mergeByKey 按 keys 匹配 repeated message 的元素，匹配到的元素递归合并，其余元素按顺序追加
+func (m *merger) mergeByKey(md protoreflect.MessageDescriptor, keys []string, dst, src []any) []any {
+	for _, item := range src {
+		sItem, ok := item.(map[string]any)
+		if !ok {
+			dst = append(dst, cloneValue(item))
+			continue
+		}
+		name, key, ok := itemKey(md, keys, sItem)
+		i := -1
+		if ok {
+			i = slices.IndexFunc(dst, func(v any) bool {
+				dItem, ok := v.(map[string]any)
+				if !ok {
+					return false
+				}
+				dName, dKey, ok := itemKey(md, keys, dItem)
+				return ok && dName == name && reflect.DeepEqual(dKey, key)
+			})
+		}
+		if i < 0 {
+			dst = append(dst, cloneValue(item))
+			continue
+		}
+		m.mergeMessage([]protoreflect.MessageDescriptor{md}, dst[i].(map[string]any), sItem)
+	}
+	return dst
+}
+
+// itemKey 返回元素中第一个有值的 key 字段
+func itemKey(md protoreflect.MessageDescriptor, keys []string, item map[string]any) (string, any, bool) {
+	for _, name := range keys {
+		fd := md.Fields().ByName(protoreflect.Name(name))
+		if fd == nil {
+			continue
+		}
+		if k, ok := lookupFieldKey(item, fd); ok && item[k] != nil {
+			return name, item[k], true
+		}
+	}
+	return "", nil, false
+}
+
+// lookupMergeField 在 mds 中查找 key 对应的字段，key 可以是 proto 名或 json 名
+func lookupMergeField(mds []protoreflect.MessageDescriptor, key string) protoreflect.FieldDescriptor {
+	for _, md := range mds {
+		if md == nil {
+			continue
+		}
+		if fd := md.Fields().ByName(protoreflect.Name(key)); fd != nil {
+			return fd
+		}
+		if fd := md.Fields().ByJSONName(key); fd != nil {
+			return fd
+		}
+	}
+	return nil
+}
+
+// lookupFieldKey 返回 values 中字段使用的 key（proto 名或 json 名）
+func lookupFieldKey(values map[string]any, fd protoreflect.FieldDescriptor) (string, bool) {
+	for _, k := range []string{string(fd.Name()), fd.JSONName()} {
+		if _, ok := values[k]; ok {
+			return k, true
+		}
+	}
+	return "", false
+}
+
+// mergeUntyped 没有描述符时的合并：map 递归合并，其他值覆盖
+func mergeUntyped(dv, sv any) any {
+	dm, dok := dv.(map[string]any)
+	sm, sok := sv.(map[string]any)
+	if !dok || !sok {
+		return cloneValue(sv)
+	}
+	for k, v := range sm {
+		if v == nil {
+			continue
+		}
+		dm[k] = mergeUntyped(dm[k], v)
+	}
+	return dm
+}
+
+// cloneValue 深拷贝 map 和 slice，避免合并结果与配置源的解析结果共享内存
+func cloneValue(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		c := make(map[string]any, len(v))
+		for k, item := range v {
+			c[k] = cloneValue(item)
+		}
+		return c
+	case []any:
+		c := make([]any, len(v))
+		for i, item := range v {
+			c[i] = cloneValue(item)
+		}
+		return c
+	default:
+		return v
+	}
+}
+
+// decodeKeyValue 解析配置项，规则与 kratos config 的默认 decoder 一致
+func decodeKeyValue(kv *KeyValue) (map[string]any, error) {
+	values := map[string]any{}
+	if kv.Format == "" {
+		// key 为 a.b.c 形式，展开为嵌套 map
+		keys := strings.Split(kv.Key, ".")
+		cur := values
+		for _, k := range keys[:len(keys)-1] {
+			next := map[string]any{}
+			cur[k] = next
+			cur = next
+		}
+		cur[keys[len(keys)-1]] = string(kv.Value)
+		return values, nil
+	}
+	codec := encoding.GetCodec(kv.Format)
+	if codec == nil {
+		return nil, errors.Errorf("unsupported key: %s format: %s", kv.Key, kv.Format)
+	}
+	if err := codec.Unmarshal(kv.Value, &values); err != nil {
+		return nil, errors.WithMessagef(err, "decode %s failed", kv.Key)
+	}
+	return normalizeValue(values).(map[string]any), nil
+}
+
+// normalizeValue 将 map[any]any 转换为 map[string]any，[]byte 转换为字符串
+func normalizeValue(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, item := range v {
+			v[k] = normalizeValue(item)
+		}
+		return v
+	case map[any]any:
+		m := make(map[string]any, len(v))
+		for k, item := range v {
+			m[fmt.Sprint(k)] = normalizeValue(item)
+		}
+		return m
+	case []any:
+		for i, item := range v {
+			v[i] = normalizeValue(item)
+		}
+		return v
+	case []byte:
+		return string(v)
+	default:
+		return v
+	}
+}
+
+// mergedSource 将所有配置源按 Merger 合并为一个配置项
+//
+// 配合 replaceMerge 使用，保证每次加载和 watch 后的配置都只取决于配置源的当前内容
+type mergedSource struct {
+	Source
+	merger *merger
+}
+
+// withMerger 包装配置源，source 的 Load 和 watch 都需要返回全部配置项
+func withMerger(merger *merger, source Source) Source {
+	return &mergedSource{Source: source, merger: merger}
+}
+
+func (s *mergedSource) Load() ([]*KeyValue, error) {
+	kvs, err := s.Source.Load()
+	if err != nil {
+		return nil, err
+	}
+	return s.merge(kvs)
+}
+
+func (s *mergedSource) Watch() (config.Watcher, error) {
+	w, err := s.Source.Watch()
+	if err != nil {
+		return nil, err
+	}
+	return &mergedWatcher{Watcher: w, source: s}, nil
+}
+
+func (s *mergedSource) merge(kvs []*KeyValue) ([]*KeyValue, error) {
+	layers := make([]map[string]any, 0, len(kvs))
+	for _, kv := range kvs {
+		values, err := decodeKeyValue(kv)
+		if err != nil {
+			return nil, err
+		}
+		layers = append(layers, values)
+	}
+	// 使用 yaml 序列化，整数不会像 json 一样变成 float64
+	data, err := encoding.GetCodec(YamlFormat).Marshal(s.merger.Merge(layers...))
+	if err != nil {
+		return nil, errors.WithMessage(err, "encode merged config failed")
+	}
+	return []*KeyValue{{Key: mergedKey, Value: data, Format: YamlFormat}}, nil
+}
+
+type mergedWatcher struct {
+	config.Watcher
+	source *mergedSource
+}
+
+func (w *mergedWatcher) Next() ([]*KeyValue, error) {
+	kvs, err := w.Watcher.Next()
+	if err != nil {
+		return nil, err
+	}
+	return w.source.merge(kvs)
+}
+
+// replaceMerge kratos config 的合并函数，mergedSource 已经合并了所有配置源，直接替换
+func replaceMerge(dst, src any) error {
+	d, ok := dst.(*map[string]any)
+	if !ok {
+		return errors.Errorf("unexpected merge dst type %T", dst)
+	}
+	s, ok := src.(map[string]any)
+	if !ok {
+		return errors.Errorf("unexpected merge src type %T", src)
+	}
+	*d = s
+	return nil
+}
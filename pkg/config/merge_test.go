@@ -0,0 +1,232 @@
+package config
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/config"
+	"github.com/jaggerzhuang1994/kratos-foundation/proto/kratos_foundation_pb"
+)
+
+const mergeTestFileConfig = `
+log:
+  filter_keys: [a, b]
+registry:
+  tags: [x]
+server:
+  http:
+    addr: 0.0.0.0:8000
+    disable_strict_slash: true
+  middleware:
+    timeout:
+      routes:
+        - path: /a
+          timeout: 1s
+        - prefix: /b
+          timeout: 2s
+database:
+  connections:
+    default:
+      dsn: file-dsn
+      replicas:
+        - dsn: r1
+        - dsn: r2
+app:
+  metadata:
+    k1: v1
+tracing:
+  disable: true
+`
+
+const mergeTestConsulConfig = `
+log:
+  filterKeys: [b, c]
+registry:
+  tags: [y]
+server:
+  http:
+    addr: 0.0.0.0:9000
+    disable_strict_slash: false
+  middleware:
+    timeout:
+      routes:
+        - path: /a
+          timeout: 3s
+        - path: /c
+          timeout: 4s
+database:
+  connections:
+    default:
+      replicas:
+        - dsn: r3
+app:
+  metadata:
+    k2: v2
+tracing:
+`
+
+// memSource 模拟 consul 配置源，watch 只返回变更的配置项
+type memSource struct {
+	kvs []*KeyValue
+	ch  chan []*KeyValue
+}
+
+func newMemSource(kvs ...*KeyValue) *memSource {
+	return &memSource{kvs: kvs, ch: make(chan []*KeyValue)}
+}
+
+func (s *memSource) Load() ([]*KeyValue, error) {
+	return s.kvs, nil
+}
+
+func (s *memSource) Watch() (config.Watcher, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &memWatcher{ch: s.ch, ctx: ctx, cancel: cancel}, nil
+}
+
+type memWatcher struct {
+	ch     chan []*KeyValue
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+func (w *memWatcher) Next() ([]*KeyValue, error) {
+	select {
+	case kvs := <-w.ch:
+		return kvs, nil
+	case <-w.ctx.Done():
+		return nil, w.ctx.Err()
+	}
+}
+
+func (w *memWatcher) Stop() error {
+	w.cancel()
+	return nil
+}
+
+func yamlKeyValue(key, value string) *KeyValue {
+	return &KeyValue{Key: key, Value: []byte(value), Format: YamlFormat}
+}
+
+// newMergeTestConfig 按 文件 < consul 的优先级创建配置
+func newMergeTestConfig(t *testing.T, consul Source) config.Config {
+	t.Helper()
+	filename := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(filename, []byte(mergeTestFileConfig), 0600); err != nil {
+		t.Fatal(err)
+	}
+	c := config.New(
+		config.WithSource(withMerger(newMerger(), NewPriorityConfigSource([]Source{
+			withSourceName(filename, newWatchedFileSource(filename)),
+			withSourceName("consul:config/app", withFullKeyValues(consul)),
+		}))),
+		config.WithMergeFunc(replaceMerge),
+	)
+	if err := c.Load(); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = c.Close() })
+	return c
+}
+
+func scanMergeTestConfig(t *testing.T, c config.Config) *kratos_foundation_pb.Config {
+	t.Helper()
+	var pb kratos_foundation_pb.Config
+	if err := c.Scan(&pb); err != nil {
+		t.Fatal(err)
+	}
+	return &pb
+}
+
+func timeoutRoutes(pb *kratos_foundation_pb.Config) map[string]time.Duration {
+	routes := map[string]time.Duration{}
+	for _, r := range pb.GetServer().GetMiddleware().GetTimeout().GetRoutes() {
+		routes[r.GetPath()+r.GetPrefix()] = r.GetTimeout().AsDuration()
+	}
+	return routes
+}
+
+func TestMergeFileAndConsul(t *testing.T) {
+	c := newMergeTestConfig(t, newMemSource(yamlKeyValue("config.yaml", mergeTestConsulConfig)))
+	pb := scanMergeTestConfig(t, c)
+
+	// APPEND：按优先级追加并去重，json 名和 proto 名合并到同一个字段
+	if got, want := pb.GetLog().GetFilterKeys(), []string{"a", "b", "c"}; !slices.Equal(got, want) {
+		t.Errorf("log.filter_keys = %v, want %v", got, want)
+	}
+	if got, want := pb.GetRegistry().GetTags(), []string{"x", "y"}; !slices.Equal(got, want) {
+		t.Errorf("registry.tags = %v, want %v", got, want)
+	}
+
+	// MERGE_BY_KEY：按 path/prefix 匹配，匹配到的覆盖，其余追加
+	routes := timeoutRoutes(pb)
+	want := map[string]time.Duration{"/a": 3 * time.Second, "/b": 2 * time.Second, "/c": 4 * time.Second}
+	if len(routes) != len(want) {
+		t.Errorf("server.http.middleware.timeout.routes = %v, want %v", routes, want)
+	}
+	for k, v := range want {
+		if routes[k] != v {
+			t.Errorf("route %s timeout = %v, want %v", k, routes[k], v)
+		}
+	}
+
+	// REPLACE：从库整体替换，其他字段按 key 合并
+	conn := pb.GetDatabase().GetConnections()["default"]
+	if conn.GetDsn() != "file-dsn" {
+		t.Errorf("database.connections.default.dsn = %q, want file-dsn", conn.GetDsn())
+	}
+	if len(conn.GetReplicas()) != 1 || conn.GetReplicas()[0].GetDsn() != "r3" {
+		t.Errorf("database.connections.default.replicas = %v, want [r3]", conn.GetReplicas())
+	}
+
+	// map 默认按 key 合并
+	if md := pb.GetApp().GetMetadata(); md["k1"] != "v1" || md["k2"] != "v2" {
+		t.Errorf("app.metadata = %v, want k1 and k2", md)
+	}
+
+	// 标量覆盖，零值也能覆盖
+	if got := pb.GetServer().GetHttp().GetAddr(); got != "0.0.0.0:9000" {
+		t.Errorf("server.http.addr = %q, want 0.0.0.0:9000", got)
+	}
+	if http := pb.GetServer().GetHttp(); http.DisableStrictSlash == nil || http.GetDisableStrictSlash() {
+		t.Errorf("server.http.disable_strict_slash = %v, want false", http.DisableStrictSlash)
+	}
+
+	// null 不覆盖低优先级的值
+	if !pb.GetTracing().GetDisable() {
+		t.Errorf("tracing.disable = false, want true")
+	}
+}
+
+func TestMergeWatchIsDeterministic(t *testing.T) {
+	consul := newMemSource(
+		yamlKeyValue("config.yaml", mergeTestConsulConfig),
+		yamlKeyValue("tags.yaml", "registry:\n  tags: [z]\n"),
+	)
+	c := newMergeTestConfig(t, consul)
+	if got, want := scanMergeTestConfig(t, c).GetRegistry().GetTags(), []string{"x", "y", "z"}; !slices.Equal(got, want) {
+		t.Fatalf("registry.tags = %v, want %v", got, want)
+	}
+
+	// consul watch 只返回变更的 config.yaml：删除的 route 和 tag 不能残留，tags.yaml 仍然生效
+	consul.ch <- []*KeyValue{yamlKeyValue("config.yaml", "registry:\n  tags: [y]\n")}
+
+	deadline := time.Now().Add(3 * time.Second)
+	for {
+		pb := scanMergeTestConfig(t, c)
+		routes := timeoutRoutes(pb)
+		if slices.Equal(pb.GetRegistry().GetTags(), []string{"x", "y", "z"}) &&
+			len(routes) == 2 && routes["/a"] == time.Second && pb.GetServer().GetHttp().GetAddr() == "0.0.0.0:8000" {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("config after watch: tags=%v routes=%v addr=%s",
+				pb.GetRegistry().GetTags(), routes, pb.GetServer().GetHttp().GetAddr())
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
@@ -10,6 +10,9 @@ import (
 	"golang.org/x/sync/errgroup"
 )
 
+// priorityConfigSource 优先级配置源，sources 按优先级从低到高排列
+//
+// Load 和 watch 都按顺序返回所有配置源的全部配置项，子配置源的 watch 也需要返回全部配置项
 type priorityConfigSource struct {
 	sources []Source
 	cached  map[Source][]*config.KeyValue
@@ -49,6 +52,11 @@ func (p *priorityConfigSource) Load() ([]*config.KeyValue, error) {
 	return kvs, nil
 }
 
+// Layers 按优先级展开所有子配置源
+func (p *priorityConfigSource) Layers() []SourceLayer {
+	return collectLayers(p.sources)
+}
+
 func (p *priorityConfigSource) Watch() (config.Watcher, error) {
 	ctx, cancel := context.WithCancel(context.Background())
 	w := &watcher{
@@ -84,12 +92,12 @@ func (p *priorityConfigSource) watch(i int, ww config.Watcher, ch chan []*config
 			p.lock.Lock()
 			defer p.lock.Unlock()
 			p.cached[p.sources[i]] = kvs
-			for j, source := range p.sources {
-				if j > i {
-					kvs = append(kvs, p.cached[source]...)
-				}
+			// 返回所有配置源的全部配置项，由 mergedSource 重新合并
+			all := make([]*config.KeyValue, 0)
+			for _, source := range p.sources {
+				all = append(all, p.cached[source]...)
 			}
-			ch <- kvs
+			ch <- all
 			return false
 		}()
 		if done {
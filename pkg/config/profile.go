@@ -0,0 +1,129 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/jaggerzhuang1994/kratos-foundation/pkg/env"
+	"github.com/jaggerzhuang1994/kratos-foundation/pkg/utils"
+	"github.com/pkg/errors"
+)
+
+// 环境 Profile 覆盖
+//
+// 根据 env.AppEnv() 自动加载对应环境的覆盖配置，优先级从低到高：
+//
+//	文件：  config.yaml  <  config.{env}.yaml  <  config.local.yaml
+//	consul：{path}       <  {path}.{env}
+//
+//   - 文件名形如 {name}.{profile}.{ext}，profile 为 local/dev/test/pre/prod 之一的视为 profile 文件，
+//     其余文件为基础配置
+//   - 同一层级内的多个文件按 FileSourcePathList 和 glob 返回的顺序排列
+//   - 目录作为一个配置源，目录内的文件按同样的规则排列（见 NewDirectorySource）
+//   - 其他环境的 profile 文件不会加载
+//   - config.local.yaml 是本机覆盖配置（一般不提交到仓库），在任何环境下优先级都最高
+//   - consul 只有环境覆盖路径，不需要提前创建，路径下有配置后 watch 会自动加载
+//
+// 文件与 consul、环境变量之间的优先级见 NewConfig。
+
+// profiles 支持的 profile，与 env 中定义的环境一致
+var profiles = []string{env.Local, env.Dev, env.Test, env.Pre, env.Prod}
+
+// fileProfile 返回文件名中的 profile，基础配置文件返回空字符串
+//
+// 例如 config.prod.yaml 返回 prod，config.yaml、app.v2.yaml 返回空字符串
+func fileProfile(filename string) string {
+	parts := strings.Split(filepath.Base(filename), ".")
+	if len(parts) < 3 {
+		return ""
+	}
+	if profile := parts[len(parts)-2]; slices.Contains(profiles, profile) {
+		return profile
+	}
+	return ""
+}
+
+// profileFilename 返回基础配置文件对应的 profile 文件名，例如 config.yaml => config.prod.yaml
+func profileFilename(filename, profile string) string {
+	ext := filepath.Ext(filename)
+	return strings.TrimSuffix(filename, ext) + "." + profile + ext
+}
+
+// expandFileProfiles 展开文件配置路径，按 profile 优先级从低到高返回需要加载的文件和目录
+//
+// 路径支持通配符；目录作为一个整体加载（见 NewDirectorySource），按基础配置排序；
+// 显式指定的基础配置文件会自动查找同目录下的 profile 文件。
+// 返回的 skipped 为其他环境的 profile 文件。
+func expandFileProfiles(appEnv string, patterns ...string) (files, skipped []string, err error) {
+	matches, err := glob(patterns...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var candidates, dirs []string
+	for _, match := range matches {
+		fi, err := os.Stat(match)
+		if err != nil {
+			return nil, nil, errors.WithMessagef(err, "stat %s failed", match)
+		}
+		candidates = append(candidates, match)
+		if fi.IsDir() {
+			dirs = append(dirs, match)
+			continue
+		}
+		if fileProfile(match) == "" {
+			candidates = append(candidates, existingFiles(profileFilename(match, appEnv), profileFilename(match, env.Local))...)
+		}
+	}
+	files, skipped = sortByProfile(appEnv, utils.Unique(candidates), dirs...)
+	return files, skipped, nil
+}
+
+// sortByProfile 按 基础配置 < 环境配置 < 本机配置 排列文件，同一层级内保持原来的顺序
+//
+// base 中的路径（目录）总是作为基础配置；skipped 为其他环境的 profile 文件
+func sortByProfile(appEnv string, filenames []string, base ...string) (sorted, skipped []string) {
+	var baseLayer, envLayer, localLayer []string
+	for _, filename := range filenames {
+		profile := fileProfile(filename)
+		if slices.Contains(base, filename) {
+			profile = ""
+		}
+		switch profile {
+		case "":
+			baseLayer = append(baseLayer, filename)
+		case env.Local:
+			localLayer = append(localLayer, filename)
+		case appEnv:
+			envLayer = append(envLayer, filename)
+		default:
+			skipped = append(skipped, filename)
+		}
+	}
+	return slices.Concat(baseLayer, envLayer, localLayer), skipped
+}
+
+// consulProfilePaths 展开 consul 配置路径，基础路径在前，环境覆盖路径 {path}.{env} 在后
+func consulProfilePaths(appEnv string, paths ...string) []string {
+	overlays := utils.Map(paths, func(path string) string {
+		return strings.TrimSuffix(path, "/") + "." + appEnv
+	})
+	return utils.Unique(slices.Concat(paths, overlays))
+}
+
+// consulKeyPrefix consul 配置路径对应的 key 前缀
+//
+// consul 按字符串前缀列出 key，config/app 会同时匹配到 config/app.prod 下的 key，
+// 因此统一以 / 结尾，只加载路径下的配置
+func consulKeyPrefix(path string) string {
+	return strings.TrimSuffix(path, "/") + "/"
+}
+
+func existingFiles(filenames ...string) []string {
+	return utils.Filter(filenames, func(filename string) bool {
+		fi, err := os.Stat(filename)
+		return err == nil && !fi.IsDir()
+	})
+}
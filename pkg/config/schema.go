@@ -0,0 +1,522 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/go-kratos/kratos/v2/config"
+	kratos_foundation "github.com/jaggerzhuang1994/kratos-foundation"
+	"github.com/jaggerzhuang1994/kratos-foundation/pkg/env"
+	"github.com/jaggerzhuang1994/kratos-foundation/pkg/log"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"gopkg.in/yaml.v3"
+)
+
+// ConfigSchemaStrictEnv 开启严格校验的环境变量
+const ConfigSchemaStrictEnv = "CONFIG_SCHEMA_STRICT"
+
+// ConfigSchemaStrict 严格校验，未知字段视为错误，默认只输出警告
+//
+// 可以通过环境变量 CONFIG_SCHEMA_STRICT=true 开启，或在 NewConfig 之前修改
+var ConfigSchemaStrict = env.GetEnvAsBool(ConfigSchemaStrictEnv)
+
+// durationPattern Duration 字段的格式
+//
+// schema 中的 pattern 不允许负数，protojson 允许（redis 的 -1s 表示不超时），以 protojson 为准
+var durationPattern = regexp.MustCompile(`^-?[0-9]+(\.[0-9]+)?s$`)
+
+// SchemaError 配置文档中单个位置的 schema 校验错误
+type SchemaError struct {
+	// Source 配置源名称，文件为文件路径，consul 为 consul:{path}
+	Source string
+	// Key 配置项的 key，文件为文件名，consul 为路径下的 key
+	Key string
+	// Pointer 出错位置的 JSON Pointer，例如 /server/stop_dealy
+	Pointer string
+	// Line 出错位置的行号，从 1 开始
+	Line int
+	// Reason 错误原因
+	Reason string
+	// Unknown 是否为未知字段
+	Unknown bool
+}
+
+// Location 出错的配置文档，例如 configs/config.yaml、consul:config/app/config.yaml
+func (e SchemaError) Location() string {
+	if e.Key == "" || filepath.Base(e.Source) == e.Key {
+		return e.Source
+	}
+	return strings.TrimSuffix(e.Source, "/") + "/" + e.Key
+}
+
+func (e SchemaError) Error() string {
+	return fmt.Sprintf("%s: %s (line %d): %s", e.Location(), e.Pointer, e.Line, e.Reason)
+}
+
+// SchemaErrors 配置文档 schema 校验的汇总错误
+type SchemaErrors []SchemaError
+
+func (e SchemaErrors) Error() string {
+	var sb strings.Builder
+	_, _ = fmt.Fprintf(&sb, "invalid config document, %d error(s):", len(e))
+	for _, se := range e {
+		_, _ = fmt.Fprintf(&sb, "\n  - %s", se.Error())
+	}
+	return sb.String()
+}
+
+// jsonSchema protoc-gen-jsonschema 生成的 schema 中用到的关键字
+type jsonSchema struct {
+	Ref                  string                 `json:"$ref"`
+	Type                 schemaTypes            `json:"type"`
+	Properties           map[string]*jsonSchema `json:"properties"`
+	AdditionalProperties *jsonSchema            `json:"additionalProperties"`
+	Items                *jsonSchema            `json:"items"`
+	AdditionalItems      *jsonSchema            `json:"additionalItems"`
+	Enum                 []any                  `json:"enum"`
+	Pattern              string                 `json:"pattern"`
+	Format               string                 `json:"format"`
+	Definitions          map[string]*jsonSchema `json:"definitions"`
+
+	// jsonNames json 名 => properties 中的 proto 名
+	jsonNames map[string]string
+	pattern   *regexp.Regexp
+}
+
+// schemaTypes type 可以是字符串或字符串数组
+type schemaTypes []string
+
+func (t *schemaTypes) UnmarshalJSON(data []byte) error {
+	var one string
+	if err := json.Unmarshal(data, &one); err == nil {
+		*t = schemaTypes{one}
+		return nil
+	}
+	var many []string
+	if err := json.Unmarshal(data, &many); err != nil {
+		return err
+	}
+	*t = many
+	return nil
+}
+
+// schemaValidator 使用框架配置的 JSON Schema 校验合并前的配置文档
+//
+// 校验规则：
+//   - 只校验 yaml 和 json 格式的配置项，其他格式在解析时报错
+//   - 校验类型、enum、pattern 和 Duration 格式；不校验 required，
+//     单个配置文档通常只包含部分配置，必填字段在合并后由 ValidateKratosFoundationConfig 校验
+//   - 值为 null 的字段不参与合并，不校验；包含 ${ 的字符串是占位符，解析后才知道类型，不校验
+//   - 整数和浮点数字段允许使用数字字符串，与 protojson 一致
+//   - 字段名可以是 proto 名或 json 名
+//   - schema 中声明了 properties 的对象，出现未声明的字段视为未知字段；
+//     根节点的字段也可以是 RegisterConfigMessage 注册的业务配置字段或 Section 的配置 key
+type schemaValidator struct {
+	root     *jsonSchema
+	defs     map[string]*jsonSchema
+	roots    []protoreflect.MessageDescriptor
+	sections []string
+}
+
+var (
+	configSchemaOnce sync.Once
+	configSchema     *jsonSchema
+	configSchemaErr  error
+)
+
+// loadConfigSchema 解析内嵌的 config.schema.json
+func loadConfigSchema() (*jsonSchema, error) {
+	configSchemaOnce.Do(func() {
+		var s jsonSchema
+		if err := json.Unmarshal(kratos_foundation.ConfigSchema, &s); err != nil {
+			configSchemaErr = errors.WithMessage(err, "parse config.schema.json failed")
+			return
+		}
+		prepared := map[*jsonSchema]bool{}
+		var prepare func(s *jsonSchema) error
+		prepare = func(s *jsonSchema) error {
+			if s == nil || prepared[s] {
+				return nil
+			}
+			prepared[s] = true
+			if s.Pattern != "" {
+				var err error
+				if s.pattern, err = regexp.Compile(s.Pattern); err != nil {
+					return errors.WithMessagef(err, "compile pattern %s failed", s.Pattern)
+				}
+			}
+			if len(s.Properties) > 0 {
+				s.jsonNames = make(map[string]string, len(s.Properties))
+				for name := range s.Properties {
+					s.jsonNames[jsonName(name)] = name
+				}
+			}
+			// 生成的 schema 使用 additionalItems 描述数组元素
+			if s.Items == nil {
+				s.Items = s.AdditionalItems
+			}
+			for _, child := range slices.Concat(
+				slices.Collect(maps.Values(s.Properties)),
+				slices.Collect(maps.Values(s.Definitions)),
+				[]*jsonSchema{s.AdditionalProperties, s.Items},
+			) {
+				if err := prepare(child); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+		if err := prepare(&s); err != nil {
+			configSchemaErr = err
+			return
+		}
+		configSchema = &s
+	})
+	return configSchema, configSchemaErr
+}
+
+// newSchemaValidator 创建校验器，roots 为配置根消息，用于判断根节点的业务配置字段
+func newSchemaValidator(roots ...protoreflect.MessageDescriptor) (*schemaValidator, error) {
+	s, err := loadConfigSchema()
+	if err != nil {
+		return nil, err
+	}
+	return &schemaValidator{root: s, defs: s.Definitions, roots: roots, sections: sectionRoots()}, nil
+}
+
+// Validate 校验一个配置项，source 为配置源名称
+//
+// 返回的错误中 Unknown 为 true 的是未知字段，由调用方决定是否视为错误
+func (v *schemaValidator) Validate(source string, kv *KeyValue) SchemaErrors {
+	if kv.Format != YamlFormat && kv.Format != "yml" && kv.Format != JsonFormat {
+		return nil
+	}
+	var doc yaml.Node
+	if err := yaml.Unmarshal(kv.Value, &doc); err != nil {
+		// 解析错误由 decodeKeyValue 返回
+		return nil
+	}
+	if doc.Kind != yaml.DocumentNode || len(doc.Content) == 0 {
+		return nil
+	}
+	w := &schemaWalker{validator: v, source: source, key: kv.Key}
+	w.walkRoot(doc.Content[0])
+	return w.errs
+}
+
+// resolve 展开 $ref
+func (v *schemaValidator) resolve(s *jsonSchema) *jsonSchema {
+	for s != nil && s.Ref != "" {
+		s = v.defs[strings.TrimPrefix(s.Ref, "#/definitions/")]
+	}
+	return s
+}
+
+// isRootField 根节点的字段是否属于注册的配置根消息或 Section
+func (v *schemaValidator) isRootField(name string) bool {
+	if slices.Contains(v.sections, name) {
+		return true
+	}
+	for _, md := range v.roots {
+		if md.Fields().ByName(protoreflect.Name(name)) != nil || md.Fields().ByJSONName(name) != nil {
+			return true
+		}
+	}
+	return false
+}
+
+type schemaWalker struct {
+	validator *schemaValidator
+	source    string
+	key       string
+	errs      SchemaErrors
+}
+
+func (w *schemaWalker) fail(n *yaml.Node, pointer string, unknown bool, format string, args ...any) {
+	w.errs = append(w.errs, SchemaError{
+		Source:  w.source,
+		Key:     w.key,
+		Pointer: pointer,
+		Line:    n.Line,
+		Reason:  fmt.Sprintf(format, args...),
+		Unknown: unknown,
+	})
+}
+
+func (w *schemaWalker) walkRoot(n *yaml.Node) {
+	n = resolveAlias(n)
+	if n.Kind != yaml.MappingNode {
+		if !isNull(n) {
+			w.fail(n, "", false, "expected object, got %s", nodeType(n))
+		}
+		return
+	}
+	root := w.validator.resolve(w.validator.root)
+	w.walkMapping(root, n, "", func(name string) bool {
+		return w.validator.isRootField(name)
+	})
+}
+
+func (w *schemaWalker) walk(s *jsonSchema, n *yaml.Node, pointer string) {
+	s = w.validator.resolve(s)
+	n = resolveAlias(n)
+	if s == nil || isNull(n) || isPlaceholder(n) {
+		return
+	}
+	if len(s.Type) > 0 && !slices.ContainsFunc(s.Type, func(t string) bool { return matchType(t, n) }) {
+		w.fail(n, pointer, false, "expected %s, got %s", strings.Join(s.Type, " or "), nodeType(n))
+		return
+	}
+
+	switch n.Kind {
+	case yaml.ScalarNode:
+		if len(s.Enum) > 0 && !slices.ContainsFunc(s.Enum, func(e any) bool { return fmt.Sprint(e) == n.Value }) {
+			w.fail(n, pointer, false, "%q is not one of %v", n.Value, s.Enum)
+			return
+		}
+		if n.Tag != "!!str" {
+			return
+		}
+		if s.Format == "duration" {
+			if !durationPattern.MatchString(n.Value) {
+				w.fail(n, pointer, false, "%q is not a duration, expected a value like 1s, 1.5s, 0.001s", n.Value)
+			}
+			return
+		}
+		if s.pattern != nil && !s.pattern.MatchString(n.Value) {
+			w.fail(n, pointer, false, "%q does not match pattern %s", n.Value, s.Pattern)
+		}
+	case yaml.MappingNode:
+		w.walkMapping(s, n, pointer, nil)
+	case yaml.SequenceNode:
+		if s.Items == nil {
+			return
+		}
+		for i, item := range n.Content {
+			w.walk(s.Items, item, pointer+"/"+strconv.Itoa(i))
+		}
+	}
+}
+
+// walkMapping 校验对象的字段，known 判断 schema 之外的已知字段
+func (w *schemaWalker) walkMapping(s *jsonSchema, n *yaml.Node, pointer string, known func(name string) bool) {
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		k, value := n.Content[i], n.Content[i+1]
+		// yaml 合并 key（<<: *anchor）由 yaml 解析时展开
+		if k.Value == "<<" {
+			continue
+		}
+		child := pointer + "/" + escapePointer(k.Value)
+		if name, ok := s.property(k.Value); ok {
+			w.walk(s.Properties[name], value, child)
+			continue
+		}
+		if s.AdditionalProperties != nil {
+			w.walk(s.AdditionalProperties, value, child)
+			continue
+		}
+		if len(s.Properties) > 0 && (known == nil || !known(k.Value)) {
+			w.fail(k, child, true, "unknown field %q", k.Value)
+		}
+	}
+}
+
+// property 按 proto 名或 json 名查找字段
+func (s *jsonSchema) property(key string) (string, bool) {
+	if _, ok := s.Properties[key]; ok {
+		return key, true
+	}
+	name, ok := s.jsonNames[key]
+	return name, ok
+}
+
+func resolveAlias(n *yaml.Node) *yaml.Node {
+	for n.Kind == yaml.AliasNode && n.Alias != nil {
+		n = n.Alias
+	}
+	return n
+}
+
+func isNull(n *yaml.Node) bool {
+	return n.Kind == yaml.ScalarNode && n.Tag == "!!null"
+}
+
+// isPlaceholder ${env:..}、${secret:..} 等占位符在合并后才解析
+func isPlaceholder(n *yaml.Node) bool {
+	return n.Kind == yaml.ScalarNode && n.Tag == "!!str" && strings.Contains(n.Value, "${")
+}
+
+func matchType(t string, n *yaml.Node) bool {
+	switch t {
+	case "object":
+		return n.Kind == yaml.MappingNode
+	case "array":
+		return n.Kind == yaml.SequenceNode
+	case "null":
+		return isNull(n)
+	}
+	if n.Kind != yaml.ScalarNode {
+		return false
+	}
+	switch t {
+	case "string":
+		return n.Tag == "!!str"
+	case "boolean":
+		return n.Tag == "!!bool"
+	case "integer":
+		if n.Tag == "!!int" {
+			return true
+		}
+		f, err := strconv.ParseFloat(n.Value, 64)
+		return (n.Tag == "!!float" || n.Tag == "!!str") && err == nil && f == float64(int64(f))
+	case "number":
+		if n.Tag == "!!int" || n.Tag == "!!float" {
+			return true
+		}
+		_, err := strconv.ParseFloat(n.Value, 64)
+		return n.Tag == "!!str" && err == nil
+	}
+	return true
+}
+
+func nodeType(n *yaml.Node) string {
+	switch n.Kind {
+	case yaml.MappingNode:
+		return "object"
+	case yaml.SequenceNode:
+		return "array"
+	}
+	switch n.Tag {
+	case "!!str":
+		return fmt.Sprintf("string %q", n.Value)
+	case "!!bool":
+		return "boolean " + n.Value
+	case "!!int", "!!float":
+		return "number " + n.Value
+	case "!!null":
+		return "null"
+	}
+	return n.Tag
+}
+
+// escapePointer 按 RFC 6901 转义 JSON Pointer 中的 ~ 和 /
+func escapePointer(s string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(s, "~", "~0"), "/", "~1")
+}
+
+// jsonName proto 字段名对应的 json 名，规则与 protoc 一致（去掉下划线，后一个字母大写）
+func jsonName(name string) string {
+	var sb strings.Builder
+	upper := false
+	for _, c := range name {
+		if c == '_' {
+			upper = true
+			continue
+		}
+		if upper && 'a' <= c && c <= 'z' {
+			c -= 'a' - 'A'
+		}
+		upper = false
+		sb.WriteRune(c)
+	}
+	return sb.String()
+}
+
+// schemaValidatedSource 合并前按配置源校验每个配置项
+//
+// Load 校验失败时返回错误，启动失败；watch 校验失败时丢弃这次变更，继续使用当前配置，
+// 直到配置文档被修正（见 ConfigAudit）。未知字段在 ConfigSchemaStrict 下视为错误，否则输出警告。
+type schemaValidatedSource struct {
+	Source
+	log       log.Log
+	validator *schemaValidator
+	strict    bool
+
+	// reported 上一次校验过的配置项，内容没有变化的配置项不再重复输出警告
+	reported map[*KeyValue]bool
+}
+
+// withSchemaValidation 包装配置源，source 需要实现 layeredSource，用于获取配置项所属的配置源名称
+func withSchemaValidation(log log.Log, validator *schemaValidator, strict bool, source Source) Source {
+	return &schemaValidatedSource{Source: source, log: log, validator: validator, strict: strict}
+}
+
+func (s *schemaValidatedSource) Load() ([]*KeyValue, error) {
+	kvs, err := s.Source.Load()
+	if err != nil {
+		return nil, err
+	}
+	if err = s.validate(kvs); err != nil {
+		return nil, err
+	}
+	return kvs, nil
+}
+
+func (s *schemaValidatedSource) Watch() (config.Watcher, error) {
+	w, err := s.Source.Watch()
+	if err != nil {
+		return nil, err
+	}
+	return &schemaValidatedWatcher{Watcher: w, source: s}, nil
+}
+
+// validate 校验所有配置项，返回 SchemaErrors
+func (s *schemaValidatedSource) validate(kvs []*KeyValue) error {
+	names := map[*KeyValue]string{}
+	if ls, ok := s.Source.(layeredSource); ok {
+		for _, layer := range ls.Layers() {
+			for _, kv := range layer.KeyValues {
+				names[kv] = layer.Name
+			}
+		}
+	}
+
+	var errs SchemaErrors
+	reported := make(map[*KeyValue]bool, len(kvs))
+	for _, kv := range kvs {
+		name, ok := names[kv]
+		if !ok {
+			name = kv.Key
+		}
+		for _, se := range s.validator.Validate(name, kv) {
+			if se.Unknown && !s.strict {
+				if !s.reported[kv] {
+					s.log.Warnf("config schema: %s", se.Error())
+				}
+				continue
+			}
+			errs = append(errs, se)
+		}
+		reported[kv] = true
+	}
+	s.reported = reported
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+type schemaValidatedWatcher struct {
+	config.Watcher
+	source *schemaValidatedSource
+}
+
+// Next 返回校验通过的配置，校验失败时返回 SchemaErrors，由 auditedWatcher 记录并丢弃这次变更
+func (w *schemaValidatedWatcher) Next() ([]*KeyValue, error) {
+	kvs, err := w.Watcher.Next()
+	if err != nil {
+		return nil, err
+	}
+	if err = w.source.validate(kvs); err != nil {
+		return nil, err
+	}
+	return kvs, nil
+}
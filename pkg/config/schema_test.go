@@ -0,0 +1,202 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	log2 "github.com/jaggerzhuang1994/kratos-foundation/pkg/log"
+	"github.com/jaggerzhuang1994/kratos-foundation/proto/kratos_foundation_pb"
+	"github.com/jaggerzhuang1994/kratos-foundation/proto/kratos_foundation_pb/config_pb"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// schemaErr 校验错误中用于对比的字段
+type schemaErr struct {
+	Pointer string
+	Line    int
+	Unknown bool
+}
+
+func validateSchema(t *testing.T, v *schemaValidator, doc string) []schemaErr {
+	t.Helper()
+	var errs []schemaErr
+	for _, se := range v.Validate("configs/config.yaml", yamlKeyValue("config.yaml", doc)) {
+		errs = append(errs, schemaErr{se.Pointer, se.Line, se.Unknown})
+	}
+	return errs
+}
+
+func newTestSchemaValidator(t *testing.T) *schemaValidator {
+	t.Helper()
+	v, err := newSchemaValidator(rootMessages()...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return v
+}
+
+// resetConfigMessages 测试结束后恢复注册的配置根消息和 Section 配置 key
+func resetConfigMessages(t *testing.T) {
+	t.Helper()
+	messages, keys := rootMessages(), sectionRoots()
+	t.Cleanup(func() {
+		configMessagesLock.Lock()
+		defer configMessagesLock.Unlock()
+		configMessages, sectionKeys = messages, keys
+	})
+}
+
+func TestSchemaValidate(t *testing.T) {
+	v := newTestSchemaValidator(t)
+	tests := []struct {
+		name string
+		doc  string
+		want []schemaErr
+	}{
+		{
+			name: "valid",
+			doc:  "app:\n  stop_timeout: 1.5s\nlog:\n  level: info\n",
+		},
+		{
+			name: "unknown field",
+			doc:  "app:\n  stop_timeout: 1s\n  stop_timout: 1s\n",
+			want: []schemaErr{{"/app/stop_timout", 3, true}},
+		},
+		{
+			name: "unknown root field",
+			doc:  "biz:\n  name: a\n",
+			want: []schemaErr{{"/biz", 1, true}},
+		},
+		{
+			name: "json name",
+			doc:  "app:\n  stopTimeout: 1s\n  disableRegistrar: true\n",
+		},
+		{
+			// json 名同样按 schema 校验，错误位置使用文档中的字段名
+			name: "json name type",
+			doc:  "app:\n  stopTimeout: 10\n",
+			want: []schemaErr{{"/app/stopTimeout", 2, false}},
+		},
+		{
+			name: "numeric string",
+			doc:  "database:\n  connections:\n    main:\n      max_idle_conns: \"10\"\n      max_open_conns: 10\n",
+		},
+		{
+			name: "invalid numeric string",
+			doc:  "database:\n  connections:\n    main:\n      max_idle_conns: \"1.5\"\n      max_open_conns: ten\n",
+			want: []schemaErr{
+				{"/database/connections/main/max_idle_conns", 4, false},
+				{"/database/connections/main/max_open_conns", 5, false},
+			},
+		},
+		{
+			// protojson 允许负数 Duration
+			name: "duration",
+			doc:  "app:\n  stop_timeout: -1s\n  registrar_timeout: 1m\n",
+			want: []schemaErr{{"/app/registrar_timeout", 3, false}},
+		},
+		{
+			name: "enum",
+			doc:  "log:\n  level: verbose\n",
+			want: []schemaErr{{"/log/level", 2, false}},
+		},
+		{
+			name: "placeholder and null",
+			doc:  "app:\n  stop_timeout: ${env:STOP_TIMEOUT}\n  registrar_timeout: null\n",
+		},
+		{
+			name: "pointer escape",
+			doc:  "app:\n  metadata:\n    a/b~c: 1\n",
+			want: []schemaErr{{"/app/metadata/a~1b~0c", 3, false}},
+		},
+		{
+			name: "array item",
+			doc:  "app:\n  endpoints:\n    - scheme: http\n      host: 127.0.0.1:8000\n    - scheme: grpc\n      hots: 127.0.0.1:9000\n",
+			want: []schemaErr{{"/app/endpoints/1/hots", 6, true}},
+		},
+		{
+			name: "json document",
+			doc:  "{\n  \"app\": {\n    \"stop_timeout\": true\n  }\n}\n",
+			want: []schemaErr{{"/app/stop_timeout", 3, false}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := validateSchema(t, v, tt.doc)
+			if len(got) != len(tt.want) {
+				t.Fatalf("errors = %+v, want %+v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("errors[%d] = %+v, want %+v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestSchemaValidateRootKeys(t *testing.T) {
+	resetConfigMessages(t)
+	doc := "name: a\nstopTimeout: 1s\nbiz:\n  timeout: 1s\nother: 1\n"
+
+	// 未注册时根节点的业务字段都是未知字段
+	if got := validateSchema(t, newTestSchemaValidator(t), doc); len(got) != 4 {
+		t.Fatalf("errors = %+v, want 4 unknown fields", got)
+	}
+
+	// RegisterConfigMessage 注册的根消息字段（proto 名和 json 名）和 Section 的配置 key 第一段是已知字段
+	RegisterConfigMessage(&kratos_foundation_pb.AppInfo{}, &config_pb.App{})
+	Section("biz.order", &structpb.Struct{})
+	want := []schemaErr{{"/other", 5, true}}
+	if got := validateSchema(t, newTestSchemaValidator(t), doc); len(got) != 1 || got[0] != want[0] {
+		t.Errorf("errors = %+v, want %+v", got, want)
+	}
+}
+
+func TestSchemaErrorMessage(t *testing.T) {
+	errs := newTestSchemaValidator(t).Validate("consul:config/app", yamlKeyValue("config.yaml", "log:\n  level: verbose\n"))
+	if len(errs) != 1 {
+		t.Fatalf("errors = %v", errs)
+	}
+	want := `consul:config/app/config.yaml: /log/level (line 2): "verbose" is not one of`
+	if got := errs.Error(); !strings.Contains(got, want) {
+		t.Errorf("error = %s, want %s", got, want)
+	}
+}
+
+func TestSchemaValidatedSourceStrict(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(filename, []byte("app:\n  stop_timout: 1s\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	// 测试中不输出日志文件
+	logConfig := log2.NewDefaultConfig()
+	logConfig.File.Disable = proto.Bool(true)
+	logger, _, err := log2.NewLogger(log2.PresetKv{}, logConfig, log2.NewHook(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	newSource := func(strict bool) Source {
+		return withSchemaValidation(log2.NewLog(logger), newTestSchemaValidator(t), strict, NewPriorityConfigSource([]Source{
+			withSourceName(filename, newWatchedFileSource(filename)),
+		}))
+	}
+
+	// 默认未知字段只输出警告
+	if _, err = newSource(false).Load(); err != nil {
+		t.Errorf("non-strict load err = %v", err)
+	}
+
+	// 严格模式下未知字段是错误，错误位置为配置源名称
+	_, err = newSource(true).Load()
+	errs, ok := err.(SchemaErrors)
+	if !ok || len(errs) != 1 {
+		t.Fatalf("strict load err = %v", err)
+	}
+	if se := errs[0]; !se.Unknown || se.Location() != filename || se.Pointer != "/app/stop_timout" || se.Line != 2 {
+		t.Errorf("error = %+v", se)
+	}
+}
@@ -0,0 +1,309 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/consul/api"
+	"github.com/jaggerzhuang1994/kratos-foundation/internal/secret"
+	"github.com/jaggerzhuang1994/kratos-foundation/pkg/consul"
+	"github.com/jaggerzhuang1994/kratos-foundation/pkg/log"
+	"github.com/pkg/errors"
+)
+
+// 内置的敏感值占位符
+const (
+	// SecretSchemeFile 读取文件内容，例如 ${secret:file:/run/secrets/db_dsn}
+	SecretSchemeFile = "secret:file"
+	// SecretSchemeEnv 读取环境变量，例如 ${env:REDIS_PASSWORD}
+	SecretSchemeEnv = "env"
+	// SecretSchemeConsul 读取 consul kv，例如 ${consul:kv/path}
+	SecretSchemeConsul = "consul"
+)
+
+// secretResolveTimeout 单个占位符的解析超时时间
+const secretResolveTimeout = 5 * time.Second
+
+// SecretResolveFunc 占位符解析函数，ref 为去掉 scheme 之后的部分
+type SecretResolveFunc func(ctx context.Context, ref string) (string, error)
+
+var (
+	secretResolversLock sync.Mutex
+	secretResolvers     = map[string]SecretResolveFunc{}
+)
+
+// RegisterSecretResolver 注册自定义的占位符解析函数
+//
+// 需要在 NewSecretResolver 之前调用（例如 main 函数开始或 init 中）。
+// scheme 可以包含 ":"，匹配时优先使用最长的 scheme。
+//
+// 示例：
+//
+//	// ${secret:vault:database/creds/app}
+//	config.RegisterSecretResolver("secret:vault", func(ctx context.Context, ref string) (string, error) {
+//	    return vaultClient.Read(ctx, ref)
+//	})
+func RegisterSecretResolver(scheme string, fn SecretResolveFunc) {
+	secretResolversLock.Lock()
+	defer secretResolversLock.Unlock()
+	secretResolvers[scheme] = fn
+}
+
+// SecretResolver 配置占位符解析器
+//
+// 在 Scan 之前替换配置中的 ${scheme:ref} 占位符，解析后的值被标记为敏感值，
+// 不会以明文出现在日志和配置导出中。
+type SecretResolver interface {
+	// Resolve 解析配置中的占位符，作为 kratos config 的 Resolver 使用
+	Resolve(input map[string]any) error
+	// IsSecret 配置路径（例如 database.connections.main.dsn）是否包含敏感值
+	IsSecret(path string) bool
+}
+
+type secretResolver struct {
+	log     log.Log
+	schemes []string // 按长度降序，保证最长匹配
+	funcs   map[string]SecretResolveFunc
+
+	mu    sync.Mutex
+	paths map[string]struct{}
+	// cache 上一次成功解析的结果，热更新时解析失败则沿用旧值
+	cache map[string]string
+}
+
+// NewSecretResolver 创建配置占位符解析器
+//
+// 内置解析函数：
+//   - ${secret:file:/path}: 读取文件内容，去掉末尾换行（k8s/docker secret）
+//   - ${env:NAME}: 读取环境变量，未设置时报错
+//   - ${consul:kv/path}: 读取 consul kv，consul 未初始化时不可用
+//
+// 其他占位符仍按 kratos 默认规则 ${key:default} 解析。
+//
+// 注意事项：
+//   - 启动时解析失败会返回错误，导致配置加载失败
+//   - 热更新时只会重新解析发生变更的配置源中的占位符，解析失败时沿用上一次的值
+func NewSecretResolver(client consul.Client, log log.Log) SecretResolver {
+	r := &secretResolver{
+		log:   log.WithModule("config/secret"),
+		funcs: map[string]SecretResolveFunc{},
+		paths: map[string]struct{}{},
+		cache: map[string]string{},
+	}
+	r.funcs[SecretSchemeFile] = resolveSecretFile
+	r.funcs[SecretSchemeEnv] = resolveSecretEnv
+	if client != nil {
+		r.funcs[SecretSchemeConsul] = newConsulSecretResolveFunc(client)
+	}
+
+	secretResolversLock.Lock()
+	for scheme, fn := range secretResolvers {
+		r.funcs[scheme] = fn
+	}
+	secretResolversLock.Unlock()
+
+	for scheme := range r.funcs {
+		r.schemes = append(r.schemes, scheme)
+	}
+	slices.SortFunc(r.schemes, func(a, b string) int {
+		return len(b) - len(a)
+	})
+	return r
+}
+
+func (r *secretResolver) Resolve(input map[string]any) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err := r.resolveMap("", input); err != nil {
+		return err
+	}
+	// 敏感值解析完成后，再按 kratos 默认规则解析 ${key:default}
+	return resolveDefaultPlaceholder(input)
+}
+
+func (r *secretResolver) IsSecret(path string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	_, ok := r.paths[path]
+	return ok
+}
+
+func (r *secretResolver) resolveMap(prefix string, sub map[string]any) error {
+	for k, v := range sub {
+		resolved, err := r.resolveValue(prefix+k, v)
+		if err != nil {
+			return err
+		}
+		sub[k] = resolved
+	}
+	return nil
+}
+
+func (r *secretResolver) resolveValue(path string, v any) (any, error) {
+	switch vt := v.(type) {
+	case string:
+		return r.expand(path, vt)
+	case map[string]any:
+		return vt, r.resolveMap(path+".", vt)
+	case []any:
+		for i, item := range vt {
+			resolved, err := r.resolveValue(path+"["+strconv.Itoa(i)+"]", item)
+			if err != nil {
+				return nil, err
+			}
+			vt[i] = resolved
+		}
+		return vt, nil
+	}
+	return v, nil
+}
+
+// expand 替换字符串中所有已注册 scheme 的占位符
+func (r *secretResolver) expand(path string, s string) (string, error) {
+	var err error
+	result := placeholderRegexp.ReplaceAllStringFunc(s, func(placeholder string) string {
+		if err != nil {
+			return placeholder
+		}
+		body := placeholder[2 : len(placeholder)-1]
+		scheme, ref, ok := r.match(body)
+		if !ok {
+			return placeholder
+		}
+		var value string
+		value, err = r.resolve(scheme, ref, body)
+		if err != nil {
+			err = errors.WithMessagef(err, "resolve %s failed", path)
+			return placeholder
+		}
+		r.paths[path] = struct{}{}
+		if value != "" && len(value) < secret.MinLength {
+			r.log.Warnf("secret resolved for %s is shorter than %d characters, "+
+				"it is masked only when a log value equals it exactly", path, secret.MinLength)
+		}
+		secret.Add(value)
+		return value
+	})
+	return result, err
+}
+
+func (r *secretResolver) match(body string) (scheme, ref string, ok bool) {
+	for _, scheme := range r.schemes {
+		if ref, ok := strings.CutPrefix(body, scheme+":"); ok {
+			return scheme, ref, true
+		}
+	}
+	return "", "", false
+}
+
+func (r *secretResolver) resolve(scheme, ref, body string) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), secretResolveTimeout)
+	defer cancel()
+	value, err := r.funcs[scheme](ctx, ref)
+	if err == nil {
+		r.cache[body] = value
+		return value, nil
+	}
+	if cached, ok := r.cache[body]; ok {
+		r.log.Warnf("resolve ${%s:...} failed, use the last resolved value: %v", scheme, err)
+		return cached, nil
+	}
+	return "", err
+}
+
+func resolveSecretFile(_ context.Context, ref string) (string, error) {
+	data, err := os.ReadFile(ref)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(string(data), "\r\n"), nil
+}
+
+func resolveSecretEnv(_ context.Context, ref string) (string, error) {
+	value, ok := os.LookupEnv(ref)
+	if !ok {
+		return "", errors.Errorf("env %s not set", ref)
+	}
+	return value, nil
+}
+
+func newConsulSecretResolveFunc(client consul.Client) SecretResolveFunc {
+	return func(ctx context.Context, ref string) (string, error) {
+		pair, _, err := client.KV().Get(ref, (&api.QueryOptions{}).WithContext(ctx))
+		if err != nil {
+			return "", err
+		}
+		if pair == nil {
+			return "", errors.Errorf("consul kv %s not found", ref)
+		}
+		return string(pair.Value), nil
+	}
+}
+
+// placeholderRegexp 与 kratos config 的占位符规则保持一致
+var placeholderRegexp = regexp.MustCompile(`\${(.*?)}`)
+
+// resolveDefaultPlaceholder kratos config 默认的 ${key:default} 解析规则
+//
+// 使用自定义 Resolver 后 kratos 不再执行默认解析（且未导出），这里保持相同的行为
+func resolveDefaultPlaceholder(input map[string]any) error {
+	mapping := func(name string) string {
+		key, def, hasDefault := strings.Cut(strings.TrimSpace(name), ":")
+		if v, ok := lookupPath(input, key); ok {
+			switch vt := v.(type) {
+			case string:
+				return vt
+			case map[string]any, []any:
+				return ""
+			}
+			return fmt.Sprint(v)
+		}
+		if hasDefault {
+			return def
+		}
+		return ""
+	}
+	var resolve func(v any) any
+	resolve = func(v any) any {
+		switch vt := v.(type) {
+		case string:
+			return placeholderRegexp.ReplaceAllStringFunc(vt, func(placeholder string) string {
+				return mapping(placeholder[2 : len(placeholder)-1])
+			})
+		case map[string]any:
+			for k, item := range vt {
+				vt[k] = resolve(item)
+			}
+		case []any:
+			for i, item := range vt {
+				vt[i] = resolve(item)
+			}
+		}
+		return v
+	}
+	resolve(input)
+	return nil
+}
+
+// lookupPath 按 "." 分隔的路径读取配置值
+func lookupPath(input map[string]any, path string) (any, bool) {
+	var cur any = input
+	for _, key := range strings.Split(path, ".") {
+		m, ok := cur.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		cur, ok = m[key]
+		if !ok {
+			return nil, false
+		}
+	}
+	return cur, true
+}
@@ -0,0 +1,174 @@
+package config
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+	"sync/atomic"
+
+	"github.com/jaggerzhuang1994/kratos-foundation/pkg/log"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+)
+
+// SectionSubscriber 业务配置段变更回调，c 为变更后的配置，old 为变更前的配置
+type SectionSubscriber[T proto.Message] func(c, old T) error
+
+// TypedSection 业务配置段，与 config_pb 中的框架配置段一样支持默认值、校验和热更新
+type TypedSection[T proto.Message] interface {
+	// Current 当前生效的配置（已合并默认值）
+	Current() T
+	// Subscribe 订阅配置变更
+	Subscribe(fn SectionSubscriber[T])
+}
+
+// SectionProvider 创建 TypedSection 的 provider
+type SectionProvider[T proto.Message] func(config Config, log log.Log) (TypedSection[T], error)
+
+// Section 声明一个业务配置段，返回用于 Wire 的 provider
+//
+// 参数：
+//   - key: 配置 key，例如 biz，支持 a.b 形式的嵌套 key
+//   - defaults: 默认配置，配置文件中的值按 proto.Merge 覆盖默认值
+//
+// 加载规则：
+//   - 启动时 Scan 配置段并合并默认值，配置段不存在时使用默认值
+//   - 消息实现了 protoc-gen-validate 生成的 ValidateAll（或 Validate）时执行校验，
+//     校验失败时 provider 返回错误，服务启动失败
+//   - 配置段变更后重新加载，Scan 或校验失败时保留旧配置，内容没有变化时不通知订阅者
+//   - 订阅者按注册顺序串行调用，返回的错误只记录日志
+//   - 与 Watch 一样，只能感知启动时已经存在的配置段
+//
+// Section 需要在 NewConfig 之前调用（通常声明为包级变量），配置 key 会加入 schema 校验的已知字段。
+//
+// 示例：
+//
+//	var bizSection = config.Section("biz", &conf.Biz{Timeout: durationpb.New(time.Second)})
+//
+//	// Wire 只接受具名函数，包一层后放入 ProviderSet
+//	func NewBizConfig(c config.Config, log log.Log) (config.TypedSection[*conf.Biz], error) {
+//	    return bizSection(c, log)
+//	}
+func Section[T proto.Message](key string, defaults T) SectionProvider[T] {
+	registerSectionKey(key)
+	return func(config Config, log log.Log) (TypedSection[T], error) {
+		return newTypedSection(config, log, key, defaults)
+	}
+}
+
+type typedSection[T proto.Message] struct {
+	config   Config
+	log      log.Log
+	key      string
+	defaults T
+
+	current  atomic.Pointer[T]
+	reloadMu sync.Mutex
+
+	mu          sync.RWMutex
+	subscribers []SectionSubscriber[T]
+}
+
+func newTypedSection[T proto.Message](config Config, log log.Log, key string, defaults T) (*typedSection[T], error) {
+	s := &typedSection[T]{
+		config:   config,
+		log:      log.WithModule("config/section"),
+		key:      key,
+		defaults: defaults,
+	}
+	c, err := s.load()
+	if err != nil {
+		return nil, err
+	}
+	s.current.Store(&c)
+
+	err = config.Watch(key, func(string, Value) {
+		s.reload()
+	})
+	if errors.Is(err, ErrNotFound) {
+		s.log.Debugf("section [%s] not found, use defaults and skip watching", key)
+		return s, nil
+	}
+	if err != nil {
+		return nil, errors.WithMessagef(err, "watch section [%s] failed", key)
+	}
+	return s, nil
+}
+
+func (s *typedSection[T]) Current() T {
+	return *s.current.Load()
+}
+
+func (s *typedSection[T]) Subscribe(fn SectionSubscriber[T]) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.subscribers = append(s.subscribers, fn)
+}
+
+// load Scan 配置段，合并默认值后校验
+func (s *typedSection[T]) load() (T, error) {
+	var zero T
+	scanned := s.defaults.ProtoReflect().New().Interface().(T)
+	err := s.config.Value(s.key).Scan(scanned)
+	if err != nil && !errors.Is(err, ErrNotFound) {
+		return zero, errors.WithMessagef(err, "load section [%s] failed", s.key)
+	}
+
+	c := proto.CloneOf(s.defaults)
+	proto.Merge(c, scanned)
+	if err = validateSection(s.key, c); err != nil {
+		return zero, err
+	}
+	return c, nil
+}
+
+// reload 重新加载配置段，发生变化时通知订阅者
+func (s *typedSection[T]) reload() {
+	s.reloadMu.Lock()
+	defer s.reloadMu.Unlock()
+
+	c, err := s.load()
+	if err != nil {
+		s.log.Errorf("reload section [%s] failed, keep the old config: %v", s.key, err)
+		return
+	}
+	old := s.Current()
+	if proto.Equal(old, c) {
+		return
+	}
+	s.current.Store(&c)
+	s.log.Infof("section [%s] reloaded", s.key)
+
+	s.mu.RLock()
+	subscribers := slices.Clone(s.subscribers)
+	s.mu.RUnlock()
+	for _, fn := range subscribers {
+		if err = s.notify(fn, c, old); err != nil {
+			s.log.Errorf("notify section [%s] subscriber failed: %v", s.key, err)
+		}
+	}
+}
+
+func (s *typedSection[T]) notify(fn SectionSubscriber[T], c, old T) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = errors.Errorf("panic: %v", r)
+		}
+	}()
+	return fn(c, old)
+}
+
+// validateSection 执行 protoc-gen-validate 生成的校验，错误路径以配置 key 为前缀
+func validateSection(key string, m proto.Message) error {
+	var err error
+	switch v := m.(type) {
+	case interface{ ValidateAll() error }:
+		err = v.ValidateAll()
+	case interface{ Validate() error }:
+		err = v.Validate()
+	}
+	if err == nil {
+		return nil
+	}
+	return errors.New(flattenValidationError(key+".", err).format(fmt.Sprintf("invalid config section [%s]", key)))
+}
@@ -0,0 +1,170 @@
+package config
+
+import (
+	"slices"
+	"sync"
+
+	"github.com/go-kratos/kratos/v2/config"
+	"github.com/pkg/errors"
+)
+
+// SourceLayer 一个配置源的最新内容
+type SourceLayer struct {
+	// Name 配置源名称，文件为文件路径，consul 为 consul:{path}，环境变量为 env
+	Name string
+	// KeyValues 配置源最近一次加载的内容
+	KeyValues []*KeyValue
+	// Fallback 配置源不可用，正在使用本地快照（仅 consul 配置源）
+	Fallback bool
+	// FallbackTotal 累计使用本地快照的次数
+	FallbackTotal int64
+}
+
+// Decode 将配置源内容解析为 map，多个 KeyValue 按顺序合并
+func (l SourceLayer) Decode() (map[string]any, error) {
+	values := map[string]any{}
+	for _, kv := range l.KeyValues {
+		next, err := decodeKeyValue(kv)
+		if err != nil {
+			return nil, errors.WithMessagef(err, "decode %s failed", l.Name)
+		}
+		mergeMap(values, next)
+	}
+	return values, nil
+}
+
+// ConfigSourceLayers 按优先级从低到高排列的配置源
+//
+// 用于配置导出时标注每个字段来自哪个配置源
+type ConfigSourceLayers interface {
+	Layers() []SourceLayer
+}
+
+// layeredSource 可以展开为多个 SourceLayer 的配置源
+type layeredSource interface {
+	Layers() []SourceLayer
+}
+
+// NewConfigSourceLayers 创建配置源列表，顺序与 NewConfig 中的优先级一致
+func NewConfigSourceLayers(
+	fileSource FileSource,
+	consulSource ConsulSource,
+	envSource EnvSource,
+) ConfigSourceLayers {
+	return &sourceLayers{orderSources(fileSource, consulSource, envSource)}
+}
+
+type sourceLayers struct {
+	sources []Source
+}
+
+func (s *sourceLayers) Layers() []SourceLayer {
+	return collectLayers(s.sources)
+}
+
+func collectLayers(sources []Source) []SourceLayer {
+	var layers []SourceLayer
+	for _, source := range sources {
+		if ls, ok := source.(layeredSource); ok {
+			layers = append(layers, ls.Layers()...)
+		}
+	}
+	return layers
+}
+
+// namedSource 记录配置源名称和最新内容
+type namedSource struct {
+	Source
+	name string
+
+	mu  sync.RWMutex
+	kvs []*KeyValue
+}
+
+// withSourceName 包装配置源，记录配置源名称和最近一次加载的内容
+//
+// source 的 watch 需要返回全部配置项（见 withFullKeyValues）
+func withSourceName(name string, source Source) Source {
+	return &namedSource{Source: source, name: name}
+}
+
+func (s *namedSource) Load() ([]*KeyValue, error) {
+	kvs, err := s.Source.Load()
+	if err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	s.kvs = kvs
+	s.mu.Unlock()
+	return kvs, nil
+}
+
+func (s *namedSource) Watch() (config.Watcher, error) {
+	w, err := s.Source.Watch()
+	if err != nil {
+		return nil, err
+	}
+	return &namedWatcher{Watcher: w, source: s}, nil
+}
+
+func (s *namedSource) Layers() []SourceLayer {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	layer := SourceLayer{Name: s.name, KeyValues: s.kvs}
+	if fs, ok := s.Source.(interface{ FallbackState() (bool, int64) }); ok {
+		layer.Fallback, layer.FallbackTotal = fs.FallbackState()
+	}
+	return []SourceLayer{layer}
+}
+
+// update 记录 watch 返回的全部配置项
+func (s *namedSource) update(kvs []*KeyValue) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.kvs = kvs
+}
+
+type namedWatcher struct {
+	config.Watcher
+	source *namedSource
+}
+
+func (w *namedWatcher) Next() ([]*KeyValue, error) {
+	kvs, err := w.Watcher.Next()
+	if err != nil {
+		return nil, err
+	}
+	w.source.update(kvs)
+	return kvs, nil
+}
+
+// mergeKeyValues 用 changed 替换 kvs 中同名的配置项（保持原来的顺序），新增的配置项追加在最后
+func mergeKeyValues(kvs, changed []*KeyValue) []*KeyValue {
+	merged := slices.Clone(kvs)
+	for _, c := range changed {
+		i := slices.IndexFunc(merged, func(kv *KeyValue) bool { return kv.Key == c.Key })
+		if i < 0 {
+			merged = append(merged, c)
+			continue
+		}
+		merged[i] = c
+	}
+	return merged
+}
+
+// mergeMap 将 src 深度合并到 dst，规则与 kratos config 的默认合并一致（map 递归合并，其他值覆盖）
+func mergeMap(dst, src map[string]any) {
+	for k, v := range src {
+		sv, ok := v.(map[string]any)
+		if !ok {
+			dst[k] = v
+			continue
+		}
+		dv, ok := dst[k].(map[string]any)
+		if !ok {
+			dv = map[string]any{}
+			dst[k] = dv
+		}
+		mergeMap(dv, sv)
+	}
+}
@@ -0,0 +1,168 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/jaggerzhuang1994/kratos-foundation/proto/kratos_foundation_pb"
+	"google.golang.org/protobuf/proto"
+)
+
+// DefaultKratosFoundationConfig 各模块的默认配置，语义校验时未配置的字段按默认值参与校验
+//
+// pkg/config 不能依赖各模块包，由 app.NewDefaultKratosFoundationConfig 汇总各模块的 DefaultConfig 后注入
+type DefaultKratosFoundationConfig KratosFoundationConfig
+
+// embeddedValidationReason protoc-gen-validate 嵌套消息校验失败时的 reason
+const embeddedValidationReason = "embedded message failed validation"
+
+// FieldError 单个配置字段的校验错误
+type FieldError struct {
+	// Path 字段路径，例如 database.connections[main].dsn
+	Path string
+	// Reason 错误原因
+	Reason string
+}
+
+// ValidationErrors 配置校验的汇总错误
+type ValidationErrors []FieldError
+
+func (e ValidationErrors) Error() string {
+	return e.format("invalid KratosFoundationConfig")
+}
+
+// format 输出汇总报告，title 为报告标题
+func (e ValidationErrors) format(title string) string {
+	var sb strings.Builder
+	_, _ = fmt.Fprintf(&sb, "%s, %d error(s):", title, len(e))
+	for _, fe := range e {
+		_, _ = fmt.Fprintf(&sb, "\n  - %s: %s", fe.Path, fe.Reason)
+	}
+	return sb.String()
+}
+
+// ValidateKratosFoundationConfig 校验框架配置
+//
+// 校验分两部分：
+//  1. proto 校验规则（protoc-gen-validate 生成的 ValidateAll，会递归校验所有 config_pb 子配置）
+//  2. 跨字段的语义校验：
+//     - app.stop_timeout 必须大于 server.stop_delay（否则排空阶段会被强制打断）
+//     - database.default 必须存在于 database.connections 中
+//     - redis.default 必须存在于 redis.connections 中
+//
+// 返回：
+//   - 所有错误汇总为一个 ValidationErrors，没有错误时返回 nil
+//
+// 注意事项：
+//   - 未配置的字段按 defaults 参与语义校验，合并规则与各模块的 NewConfig 一致（proto.Merge 覆盖默认值）
+//   - 没有配置任何连接的 database/redis 不做校验（可能未启用该模块）
+//   - 配置和 defaults 中都没有的值不做校验
+func ValidateKratosFoundationConfig(c KratosFoundationConfig, defaults DefaultKratosFoundationConfig) error {
+	var errs ValidationErrors
+	if err := c.ValidateAll(); err != nil {
+		errs = append(errs, flattenValidationError("", err)...)
+	}
+	errs = append(errs, validateSemantics(c, defaults)...)
+	if len(errs) == 0 {
+		return nil
+	}
+	return errs
+}
+
+// validateSemantics 跨字段语义校验，c 先按 defaults 合并默认值
+func validateSemantics(c *kratos_foundation_pb.Config, defaults DefaultKratosFoundationConfig) ValidationErrors {
+	var errs ValidationErrors
+
+	merged := &kratos_foundation_pb.Config{}
+	if defaults != nil {
+		proto.Merge(merged, (*kratos_foundation_pb.Config)(defaults))
+	}
+	proto.Merge(merged, c)
+	c = merged
+
+	if stopTimeout := c.GetApp().GetStopTimeout(); stopTimeout != nil {
+		stopDelay := c.GetServer().GetStopDelay().AsDuration()
+		if stopDelay > 0 && stopTimeout.AsDuration() <= stopDelay {
+			errs = append(errs, FieldError{
+				Path:   "app.stop_timeout",
+				Reason: fmt.Sprintf("must be greater than server.stop_delay (%s), got %s", stopDelay, stopTimeout.AsDuration()),
+			})
+		}
+	}
+
+	if conns := c.GetDatabase().GetConnections(); len(conns) > 0 && c.GetDatabase().Default != nil {
+		name := c.GetDatabase().GetDefault()
+		if _, ok := conns[name]; !ok {
+			errs = append(errs, FieldError{
+				Path:   "database.default",
+				Reason: fmt.Sprintf("connection %q not found in database.connections", name),
+			})
+		}
+	}
+
+	if conns := c.GetRedis().GetConnections(); len(conns) > 0 && c.GetRedis().Default != nil {
+		name := c.GetRedis().GetDefault()
+		if _, ok := conns[name]; !ok {
+			errs = append(errs, FieldError{
+				Path:   "redis.default",
+				Reason: fmt.Sprintf("connection %q not found in redis.connections", name),
+			})
+		}
+	}
+	return errs
+}
+
+// flattenValidationError 将 protoc-gen-validate 的嵌套错误展开为带字段路径的错误列表
+//
+// 生成代码中 MultiError 实现 AllErrors，单个 ValidationError 实现 Field/Reason/Cause，
+// 嵌套消息校验失败时 Cause 为子消息的错误
+func flattenValidationError(prefix string, err error) ValidationErrors {
+	if multi, ok := err.(interface{ AllErrors() []error }); ok {
+		var errs ValidationErrors
+		for _, e := range multi.AllErrors() {
+			errs = append(errs, flattenValidationError(prefix, e)...)
+		}
+		return errs
+	}
+
+	ve, ok := err.(interface {
+		Field() string
+		Reason() string
+		Cause() error
+	})
+	if !ok {
+		return ValidationErrors{{Path: strings.TrimSuffix(prefix, "."), Reason: err.Error()}}
+	}
+
+	path := prefix + toSnakeField(ve.Field())
+	if ve.Cause() != nil && ve.Reason() == embeddedValidationReason {
+		return flattenValidationError(path+".", ve.Cause())
+	}
+	reason := ve.Reason()
+	if ve.Cause() != nil {
+		reason += ": " + ve.Cause().Error()
+	}
+	return ValidationErrors{{Path: path, Reason: reason}}
+}
+
+// toSnakeField 将生成代码中的 Go 字段名转换为配置中的字段名
+//
+// 例如 StopTimeout => stop_timeout，Connections[main] => connections[main]
+func toSnakeField(field string) string {
+	name, key, hasKey := strings.Cut(field, "[")
+	var sb strings.Builder
+	for i, r := range name {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				sb.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		sb.WriteRune(r)
+	}
+	if hasKey {
+		sb.WriteString("[" + key)
+	}
+	return sb.String()
+}
@@ -0,0 +1,41 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jaggerzhuang1994/kratos-foundation/proto/kratos_foundation_pb"
+	"github.com/jaggerzhuang1994/kratos-foundation/proto/kratos_foundation_pb/config_pb"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestValidateSemanticsDefaults(t *testing.T) {
+	defaults := &kratos_foundation_pb.Config{
+		App:      &config_pb.App{StopTimeout: durationpb.New(10 * time.Second)},
+		Database: &config_pb.Database{Default: proto.String("main")},
+	}
+	c := &kratos_foundation_pb.Config{
+		Server: &config_pb.Server{StopDelay: durationpb.New(20 * time.Second)},
+		Database: &config_pb.Database{
+			Connections: map[string]*config_pb.DBConnection{"main": {}},
+		},
+		Redis: &config_pb.Redis{
+			Connections: map[string]*config_pb.RedisOption{"cache": {}},
+		},
+	}
+
+	// 未配置的字段按注入的默认配置校验；默认配置中也没有的 redis.default 不校验
+	errs := validateSemantics(c, defaults)
+	if len(errs) != 1 || errs[0].Path != "app.stop_timeout" {
+		t.Fatalf("errors = %+v", errs)
+	}
+
+	// 配置的值覆盖默认值
+	c.App = &config_pb.App{StopTimeout: durationpb.New(30 * time.Second)}
+	c.Database.Default = proto.String("other")
+	errs = validateSemantics(c, defaults)
+	if len(errs) != 1 || errs[0].Path != "database.default" {
+		t.Fatalf("errors = %+v", errs)
+	}
+}
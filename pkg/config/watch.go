@@ -0,0 +1,195 @@
+package config
+
+import (
+	"maps"
+	"slices"
+	"sync"
+	"sync/atomic"
+
+	"github.com/jaggerzhuang1994/kratos-foundation/pkg/log"
+	"github.com/jaggerzhuang1994/kratos-foundation/proto/kratos_foundation_pb"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// KratosFoundationConfig 的顶层配置段，与 config.proto 中 Config 的字段名一致
+const (
+	SectionApp       = "app"
+	SectionLog       = "log"
+	SectionMetrics   = "metrics"
+	SectionTracing   = "tracing"
+	SectionServer    = "server"
+	SectionDatabase  = "database"
+	SectionDiscovery = "discovery"
+	SectionRedis     = "redis"
+	SectionRegistry  = "registry"
+	SectionClient    = "client"
+	SectionJob       = "job"
+)
+
+// Subscriber 配置段变更回调，c 为变更后的完整配置，old 为变更前的完整配置
+type Subscriber func(c, old KratosFoundationConfig) error
+
+// Watch 配置热更新
+//
+// 配置源变更后重新 Scan 出 KratosFoundationConfig，逐个配置段对比，
+// 只有发生变化的配置段才会通知对应的订阅者。
+//
+// 注意事项：
+//   - 只能感知启动时已经存在的配置段（kratos config.Watch 的限制）
+//   - 新配置 Scan 或校验失败时保留旧的 KratosFoundationConfig，不通知订阅者，ConfigAudit 记录为 failed 或 rejected；
+//     kratos config 已经合并了新的配置项，Config.Value 读到的是新的值
+//   - 订阅者按注册顺序串行调用，返回的错误只记录日志
+type Watch interface {
+	// Current 当前生效的配置
+	Current() KratosFoundationConfig
+	// Subscribe 订阅配置段变更，section 取值见 Section* 常量
+	Subscribe(section string, fn Subscriber)
+}
+
+type subscription struct {
+	section string
+	fn      Subscriber
+}
+
+type watch struct {
+	config   Config
+	defaults DefaultKratosFoundationConfig
+	log      log.Log
+	audit    *configAudit
+
+	current  atomic.Pointer[kratos_foundation_pb.Config]
+	reloadMu sync.Mutex
+
+	mu            sync.RWMutex
+	subscriptions []subscription
+}
+
+func NewWatch(
+	config Config,
+	kfc KratosFoundationConfig,
+	defaults DefaultKratosFoundationConfig,
+	audit ConfigAudit,
+	logger log.UpdateLogger,
+	log log.Log,
+) (Watch, error) {
+	w := &watch{
+		config:   config,
+		defaults: defaults,
+		log:      log.WithModule("config/watch"),
+	}
+	w.audit, _ = audit.(*configAudit)
+	w.current.Store(kfc)
+
+	// 内置订阅：日志级别等配置
+	w.Subscribe(SectionLog, func(c, _ KratosFoundationConfig) error {
+		return logger.Update(c.GetLog())
+	})
+
+	fields := kfc.ProtoReflect().Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		section := string(fields.Get(i).Name())
+		err := config.Watch(section, func(string, Value) {
+			w.reload()
+		})
+		if errors.Is(err, ErrNotFound) {
+			w.log.Debugf("section [%s] not found, skip watching", section)
+			continue
+		}
+		if err != nil {
+			return nil, errors.WithMessagef(err, "watch section [%s] failed", section)
+		}
+	}
+	return w, nil
+}
+
+func (w *watch) Current() KratosFoundationConfig {
+	return w.current.Load()
+}
+
+func (w *watch) Subscribe(section string, fn Subscriber) {
+	if w.Current().ProtoReflect().Descriptor().Fields().ByName(protoreflect.Name(section)) == nil {
+		w.log.Warnf("subscribe unknown section [%s]", section)
+		return
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.subscriptions = append(w.subscriptions, subscription{section, fn})
+}
+
+// reload 重新 Scan 配置并通知变更的配置段
+//
+// kratos 会对每个变更的 key 分别回调，多个配置段同时变更时会多次调用 reload，
+// 第一次 reload 之后配置已经是最新的，后续调用对比不出差异，因此不会重复通知
+func (w *watch) reload() {
+	w.reloadMu.Lock()
+	defer w.reloadMu.Unlock()
+
+	var c kratos_foundation_pb.Config
+	if err := w.config.Scan(&c); err != nil {
+		w.log.Error("reload KratosFoundationConfig failed, keep the old config: ", err)
+		w.resolveAudit(ReloadResultFailed, err)
+		return
+	}
+	if err := ValidateKratosFoundationConfig(&c, w.defaults); err != nil {
+		w.log.Error("reload KratosFoundationConfig failed, keep the old config: ", err)
+		w.resolveAudit(ReloadResultRejected, err)
+		return
+	}
+	w.resolveAudit(ReloadResultSuccess, nil)
+
+	old := w.current.Load()
+	changed := diffSections(old, &c)
+	if len(changed) == 0 {
+		return
+	}
+	w.current.Store(&c)
+	w.log.Infof("config reloaded, changed sections: %v", slices.Sorted(maps.Keys(changed)))
+
+	w.mu.RLock()
+	subscriptions := slices.Clone(w.subscriptions)
+	w.mu.RUnlock()
+	for _, s := range subscriptions {
+		if !changed[s.section] {
+			continue
+		}
+		if err := w.notify(s, &c, old); err != nil {
+			w.log.Errorf("notify section [%s] subscriber failed: %v", s.section, err)
+		}
+	}
+}
+
+// resolveAudit 记录配置变更审计的校验结果，没有启用审计时忽略
+func (w *watch) resolveAudit(result string, err error) {
+	if w.audit != nil {
+		w.audit.resolve(result, err)
+	}
+}
+
+func (w *watch) notify(s subscription, c, old KratosFoundationConfig) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = errors.Errorf("panic: %v", r)
+		}
+	}()
+	return s.fn(c, old)
+}
+
+// diffSections 对比两份配置，返回发生变化的配置段
+func diffSections(a, b KratosFoundationConfig) map[string]bool {
+	changed := map[string]bool{}
+	ra, rb := a.ProtoReflect(), b.ProtoReflect()
+	fields := ra.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if ra.Has(fd) != rb.Has(fd) {
+			changed[string(fd.Name())] = true
+			continue
+		}
+		if ra.Has(fd) && !proto.Equal(ra.Get(fd).Message().Interface(), rb.Get(fd).Message().Interface()) {
+			changed[string(fd.Name())] = true
+		}
+	}
+	return changed
+}
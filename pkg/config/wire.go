@@ -5,6 +5,11 @@ import "github.com/google/wire"
 var ProviderSet = wire.NewSet(
 	NewConsulSource,
 	NewFileSource,
+	NewEnvSource,
+	NewSecretResolver,
+	NewConfigAudit,
 	NewConfig,
+	NewConfigSourceLayers,
 	NewKratosFoundationConfig,
+	NewWatch,
 )
@@ -1,10 +1,12 @@
 package consul
 
 import (
+	"context"
 	"net"
 
 	"github.com/hashicorp/consul/api"
 	"github.com/jaggerzhuang1994/kratos-foundation/pkg/env"
+	"github.com/jaggerzhuang1994/kratos-foundation/pkg/health"
 	"github.com/jaggerzhuang1994/kratos-foundation/pkg/log"
 	"github.com/pkg/errors"
 )
@@ -15,7 +17,7 @@ var DefaultIPResolver = net.LookupIP
 
 const DisableConsul = "DISABLE_CONSUL"
 
-func NewConsul(log log.Log) (Client, error) {
+func NewConsul(log log.Log, hc health.Health) (Client, error) {
 	log = log.WithModule("consul")
 
 	// 如果指定 DISABLE_CONSUL
@@ -45,5 +47,11 @@ func NewConsul(log log.Log) (Client, error) {
 		return nil, errors.WithMessage(err, "调用 consul.Leader 失败")
 	}
 
+	// consul 不可用时，已注册的服务和已拉取的配置仍可继续工作，因此只作为可选检查项
+	hc.Register("consul", func(ctx context.Context) error {
+		_, err := client.Status().LeaderWithQueryOptions((&api.QueryOptions{}).WithContext(ctx))
+		return err
+	}, health.WithOptional())
+
 	return client, nil
 }
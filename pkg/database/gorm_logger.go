@@ -1,20 +1,38 @@
 package database
 
 import (
+	"context"
 	"strings"
+	"time"
 
+	log2 "github.com/go-kratos/kratos/v2/log"
 	"github.com/jaggerzhuang1994/kratos-foundation/pkg/log"
 	"github.com/jaggerzhuang1994/kratos-foundation/proto/kratos_foundation_pb/config_pb"
 	"gorm.io/gorm/logger"
 )
 
+// GormLoggerModule gorm 日志的模块名称
+const GormLoggerModule = "database/gorm"
+
 type GormLogger logger.Interface
 
 type gormLoggerWriter struct {
 	log.Log
 }
 
-func NewGormLogger(log log.Log, conf Config) GormLogger {
+// gormLogger 按 database/gorm 模块临时调整的级别切换 gorm 的日志级别
+//
+// gorm 的日志都以 debug 级别输出，database.gorm.logger.level 默认为 Silent，
+// 通过 ModuleLevels 临时调整为 debug 时输出全部 SQL（gorm Info），调整为更高的级别时不输出，
+// 没有临时调整时使用配置的级别
+type gormLogger struct {
+	logger.Interface
+	verbose logger.Interface
+	silent  logger.Interface
+	levels  log.ModuleLevels
+}
+
+func NewGormLogger(log log.Log, levels log.ModuleLevels, conf Config) GormLogger {
 	gormLogger := conf.GetGorm().GetLogger()
 
 	var level = logger.Silent
@@ -27,8 +45,8 @@ func NewGormLogger(log log.Log, conf Config) GormLogger {
 		level = logger.Error
 	}
 
-	return logger.New(&gormLoggerWriter{
-		log.WithModule("database/gorm", conf.GetLog()).AddCallerDepth(),
+	l := logger.New(&gormLoggerWriter{
+		log.WithModule(GormLoggerModule, conf.GetLog()).AddCallerDepth(),
 	}, logger.Config{
 		SlowThreshold:             gormLogger.GetSlowThreshold().AsDuration(),
 		Colorful:                  gormLogger.GetColorful(),
@@ -36,6 +54,49 @@ func NewGormLogger(log log.Log, conf Config) GormLogger {
 		ParameterizedQueries:      gormLogger.GetParameterizedQueries(),
 		LogLevel:                  level,
 	})
+	return newGormLogger(l, levels)
+}
+
+func newGormLogger(l logger.Interface, levels log.ModuleLevels) GormLogger {
+	return &gormLogger{
+		Interface: l,
+		verbose:   l.LogMode(logger.Info),
+		silent:    l.LogMode(logger.Silent),
+		levels:    levels,
+	}
+}
+
+// current 当前生效的日志器
+func (l *gormLogger) current() logger.Interface {
+	level, ok := l.levels.ModuleOverrideLevel(GormLoggerModule)
+	if !ok {
+		return l.Interface
+	}
+	if level <= log2.LevelDebug {
+		return l.verbose
+	}
+	return l.silent
+}
+
+// LogMode 显式设置的级别（例如 db.Debug()）同样会被临时调整的级别覆盖
+func (l *gormLogger) LogMode(level logger.LogLevel) logger.Interface {
+	return newGormLogger(l.Interface.LogMode(level), l.levels)
+}
+
+func (l *gormLogger) Info(ctx context.Context, msg string, data ...any) {
+	l.current().Info(ctx, msg, data...)
+}
+
+func (l *gormLogger) Warn(ctx context.Context, msg string, data ...any) {
+	l.current().Warn(ctx, msg, data...)
+}
+
+func (l *gormLogger) Error(ctx context.Context, msg string, data ...any) {
+	l.current().Error(ctx, msg, data...)
+}
+
+func (l *gormLogger) Trace(ctx context.Context, begin time.Time, fc func() (sql string, rowsAffected int64), err error) {
+	l.current().Trace(ctx, begin, fc, err)
 }
 
 func (w *gormLoggerWriter) Printf(s string, i ...any) {
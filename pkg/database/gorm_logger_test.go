@@ -0,0 +1,57 @@
+package database
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	log2 "github.com/go-kratos/kratos/v2/log"
+	"github.com/jaggerzhuang1994/kratos-foundation/pkg/log"
+	"google.golang.org/protobuf/proto"
+	"gorm.io/gorm/logger"
+)
+
+// countWriter 记录 gorm 输出的日志条数
+type countWriter struct {
+	n int
+}
+
+func (w *countWriter) Printf(string, ...any) {
+	w.n++
+}
+
+func TestGormLoggerFollowsModuleLevel(t *testing.T) {
+	// 测试中不输出日志文件
+	logConfig := log.NewDefaultConfig()
+	logConfig.File.Disable = proto.Bool(true)
+	levels, _, err := log.NewLogger(log.PresetKv{}, logConfig, log.NewHook(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	log.NewLog(levels).WithModule(GormLoggerModule)
+
+	w := &countWriter{}
+	l := newGormLogger(logger.New(w, logger.Config{LogLevel: logger.Silent}), levels)
+	trace := func() {
+		l.Trace(context.Background(), time.Now(), func() (string, int64) { return "SELECT 1", 1 }, nil)
+	}
+
+	trace()
+	if w.n != 0 {
+		t.Fatalf("silent logger wrote %d records", w.n)
+	}
+	if _, err := levels.SetModuleLevel(GormLoggerModule, log2.LevelDebug, time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	trace()
+	if w.n != 1 {
+		t.Fatalf("records = %d after override to debug, want 1", w.n)
+	}
+	if _, err := levels.ResetModuleLevel(GormLoggerModule); err != nil {
+		t.Fatal(err)
+	}
+	trace()
+	if w.n != 1 {
+		t.Fatalf("records = %d after reset, want 1", w.n)
+	}
+}
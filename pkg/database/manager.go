@@ -3,6 +3,7 @@ package database
 import (
 	"context"
 
+	"github.com/jaggerzhuang1994/kratos-foundation/pkg/health"
 	"github.com/jaggerzhuang1994/kratos-foundation/pkg/log"
 	"gorm.io/gorm"
 	"gorm.io/plugin/dbresolver"
@@ -27,37 +28,51 @@ func NewManager(
 	tracingPlugin TracingPlugin,
 	metricsPlugin MetricsPlugin,
 	dbResolver DbResolver,
-) (Manager, error) {
+	hc health.Health,
+) (Manager, func(), error) {
 	db, err := gorm.Open(defaultConnection, gormConfig)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	// tracing plugin
 	if tracingPlugin != nil {
 		if err = db.Use(tracingPlugin); err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 	}
 
 	// metrics plugin
 	if metricsPlugin != nil {
 		if err = db.Use(metricsPlugin); err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 	}
 
 	// dbresolver
 	if dbResolver != nil {
 		if err = db.Use(dbResolver); err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 	}
 
-	return &manager{
+	// 就绪检查：ping 默认连接
+	hc.Register("database", func(ctx context.Context) error {
+		sqlDB, err := db.DB()
+		if err != nil {
+			return err
+		}
+		return sqlDB.PingContext(ctx)
+	})
+
+	mgr := &manager{
 		db,
 		log.WithModule("database", config.GetLog()),
 		dbResolver,
+	}
+	return mgr, func() {
+		hc.Unregister("database")
+		mgr.release()
 	}, nil
 }
 
@@ -85,3 +100,25 @@ func (mgr *manager) GetConnection(ctx context.Context) *gorm.DB {
 
 	return db
 }
+
+// release 关闭默认连接和 dbresolver 的连接
+func (mgr *manager) release() {
+	if resolver, ok := mgr.dbResolver.(interface {
+		Call(fc func(connPool gorm.ConnPool) error) error
+	}); ok {
+		_ = resolver.Call(func(connPool gorm.ConnPool) error {
+			if closer, ok := connPool.(interface{ Close() error }); ok {
+				_ = closer.Close()
+			}
+			return nil
+		})
+	}
+	sqlDB, err := mgr.db.DB()
+	if err != nil {
+		mgr.log.Warnf("get database connection failed: %v", err)
+		return
+	}
+	if err = sqlDB.Close(); err != nil {
+		mgr.log.Warnf("close database connection failed: %v", err)
+	}
+}
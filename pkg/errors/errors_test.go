@@ -1,6 +1,7 @@
 package errors
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/pkg/errors"
@@ -28,3 +29,23 @@ func TestErr(t *testing.T) {
 		WithReasonCode(200001)
 	t.Logf("%+v", err)
 }
+
+func TestLogKeyvals(t *testing.T) {
+	// 没有 WithErrStack 时使用 cause 链中 github.com/pkg/errors 记录的堆栈
+	err := New(500, "INTERNAL", "internal error").WithCause(errors.New("cause"))
+	frames := err.StackFrames()
+	if len(frames) == 0 || !strings.HasSuffix(frames[0].Function, "TestLogKeyvals") || frames[0].Line == 0 {
+		t.Errorf("frames = %v", frames)
+	}
+	if _, ok := Find(errors.New("plain")); ok {
+		t.Error("plain error should not be found")
+	}
+	se, ok := Find(errors.WithMessage(err, "wrapped"))
+	if !ok || se.Reason != "INTERNAL" {
+		t.Errorf("find = %v %v", se, ok)
+	}
+	keyvals := se.LogKeyvals()
+	if len(keyvals) != 12 || keyvals[0] != "code" || keyvals[4] != "reason_code" || keyvals[8] != "causes" || keyvals[10] != "stack" {
+		t.Errorf("keyvals = %v", keyvals)
+	}
+}
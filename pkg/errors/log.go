@@ -0,0 +1,168 @@
+package errors
+
+import (
+	"runtime"
+	"strconv"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/jaggerzhuang1994/kratos-foundation/pkg/utils"
+	pkgerrors "github.com/pkg/errors"
+)
+
+// 日志中不输出的 md key，err_stack 和 reason_code 单独输出，其他为 http 渲染和校验的内部数据
+var logExcludeMdKeys = []string{
+	mdErrStackKey, mdReasonCodeKey, mdHttpDataKey, mdHttpHeadersKey, mdHttpResponse, mdValidationErrorKey,
+}
+
+// StackFrame 堆栈帧
+type StackFrame struct {
+	Function string
+	File     string
+	Line     int
+}
+
+// String 格式为 function (file:line)
+func (f StackFrame) String() string {
+	return f.Function + " (" + f.File + ":" + strconv.Itoa(f.Line) + ")"
+}
+
+// Find 查找 err 链中的 *Error
+// 与 FromError 不同，err 链中没有 *Error 时返回 false，不会转换为 UnknownCode 的错误
+func Find(err error) (*Error, bool) {
+	if err == nil {
+		return nil, false
+	}
+	if se := new(Error); errors.As(err, &se) {
+		return se, true
+	}
+	return nil, false
+}
+
+// LogMetadata 日志中输出的 metadata，不包括 err_stack、reason_code 等内部 key
+func (e *Error) LogMetadata() map[string]string {
+	if e == nil {
+		return nil
+	}
+	var md map[string]string
+	for k, v := range e.Metadata {
+		if utils.Includes(logExcludeMdKeys, k) {
+			continue
+		}
+		if md == nil {
+			md = make(map[string]string, len(e.Metadata))
+		}
+		md[k] = v
+	}
+	return md
+}
+
+// Causes 错误原因链，依次为 cause 及其 Unwrap 的错误信息，相邻重复的信息只保留一个
+func (e *Error) Causes() []string {
+	if e == nil {
+		return nil
+	}
+	var causes []string
+	for cause := e.cause; cause != nil; cause = errors.Unwrap(cause) {
+		msg := cause.Error()
+		if len(causes) > 0 && causes[len(causes)-1] == msg {
+			continue
+		}
+		causes = append(causes, msg)
+	}
+	return causes
+}
+
+// StackFrames 错误堆栈，栈顶在前
+// 优先使用 WithErrStack 携带的堆栈，没有时使用 cause 链中 github.com/pkg/errors 记录的堆栈
+func (e *Error) StackFrames() []StackFrame {
+	if e == nil {
+		return nil
+	}
+	if frames := parseErrStack(e.Metadata[mdErrStackKey]); len(frames) > 0 {
+		return frames
+	}
+	for cause := e.cause; cause != nil; cause = errors.Unwrap(cause) {
+		st, ok := cause.(interface{ StackTrace() pkgerrors.StackTrace })
+		if !ok {
+			continue
+		}
+		frames := make([]StackFrame, 0, len(st.StackTrace()))
+		for _, pc := range st.StackTrace() {
+			frames = append(frames, stackFrame(uintptr(pc)))
+		}
+		return frames
+	}
+	return nil
+}
+
+// LogKeyvals 结构化的日志字段，依次为 code、reason、reason_code、message、metadata、causes、stack
+// metadata、causes、stack 为空时不输出，stack 为 StackFrame.String 的数组
+func (e *Error) LogKeyvals() []any {
+	if e == nil {
+		return nil
+	}
+	keyvals := []any{
+		"code", e.Code,
+		"reason", e.Reason,
+		"reason_code", e.ReasonCode(),
+		"message", e.Message,
+	}
+	if md := e.LogMetadata(); len(md) > 0 {
+		keyvals = append(keyvals, "metadata", md)
+	}
+	if causes := e.Causes(); len(causes) > 0 {
+		keyvals = append(keyvals, "causes", causes)
+	}
+	if frames := e.StackFrames(); len(frames) > 0 {
+		stack := make([]string, len(frames))
+		for i, frame := range frames {
+			stack[i] = frame.String()
+		}
+		keyvals = append(keyvals, "stack", stack)
+	}
+	return keyvals
+}
+
+// LogValue LogKeyvals 转换的 map，用于作为一个日志字段的值输出
+func (e *Error) LogValue() map[string]any {
+	keyvals := e.LogKeyvals()
+	value := make(map[string]any, len(keyvals)/2)
+	for i := 0; i < len(keyvals); i += 2 {
+		value[keyvals[i].(string)] = keyvals[i+1]
+	}
+	return value
+}
+
+// parseErrStack 解析 WithErrStack 记录的堆栈，每一帧为 "\nfunction\n\tfile:line"
+func parseErrStack(errStack string) []StackFrame {
+	if errStack == "" {
+		return nil
+	}
+	var frames []StackFrame
+	var function string
+	for _, line := range strings.Split(errStack, "\n") {
+		if !strings.HasPrefix(line, "\t") {
+			function = line
+			continue
+		}
+		frame := StackFrame{Function: function, File: strings.TrimPrefix(line, "\t")}
+		if i := strings.LastIndexByte(frame.File, ':'); i > 0 {
+			frame.Line, _ = strconv.Atoi(frame.File[i+1:])
+			frame.File = frame.File[:i]
+		}
+		frames = append(frames, frame)
+		function = ""
+	}
+	return frames
+}
+
+// stackFrame 与 github.com/pkg/errors 的 Frame 一致，pc 为返回地址
+func stackFrame(pc uintptr) StackFrame {
+	fn := runtime.FuncForPC(pc - 1)
+	if fn == nil {
+		return StackFrame{Function: "unknown", File: "unknown"}
+	}
+	file, line := fn.FileLine(pc - 1)
+	return StackFrame{Function: fn.Name(), File: file, Line: line}
+}
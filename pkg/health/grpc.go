@@ -0,0 +1,107 @@
+package health
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+// LivenessService gRPC 健康检查中表示存活检查的服务名
+//
+// 服务名约定：
+//   - "": 整体就绪状态
+//   - "liveness": 整体存活状态
+//   - 其他: 对应名称的检查项
+const LivenessService = "liveness"
+
+// watchInterval Watch 接口的轮询间隔
+const watchInterval = 5 * time.Second
+
+// GrpcHealthServer 基于 Health 的 gRPC 健康检查服务
+//
+// 用于替代 kratos 内置的 grpc health server（配置 custom_health 后生效），
+// 每次请求都会实时执行检查项，因此 k8s/consul 的 grpc 探针能感知真实依赖状态。
+type GrpcHealthServer struct {
+	grpc_health_v1.UnimplementedHealthServer
+	health Health
+}
+
+func NewGrpcHealthServer(health Health) *GrpcHealthServer {
+	return &GrpcHealthServer{health: health}
+}
+
+func (s *GrpcHealthServer) Check(ctx context.Context, req *grpc_health_v1.HealthCheckRequest) (*grpc_health_v1.HealthCheckResponse, error) {
+	st, ok := s.status(ctx, req.GetService())
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "unknown service %q", req.GetService())
+	}
+	return &grpc_health_v1.HealthCheckResponse{Status: st}, nil
+}
+
+func (s *GrpcHealthServer) List(ctx context.Context, _ *grpc_health_v1.HealthListRequest) (*grpc_health_v1.HealthListResponse, error) {
+	report := s.health.Readiness(ctx)
+	resp := &grpc_health_v1.HealthListResponse{
+		Statuses: map[string]*grpc_health_v1.HealthCheckResponse{
+			"": {Status: toServingStatus(report.Healthy())},
+		},
+	}
+	for _, r := range report.Checks {
+		resp.Statuses[r.Name] = &grpc_health_v1.HealthCheckResponse{Status: toServingStatus(r.Status == StatusUp)}
+	}
+	return resp, nil
+}
+
+func (s *GrpcHealthServer) Watch(req *grpc_health_v1.HealthCheckRequest, stream grpc_health_v1.Health_WatchServer) error {
+	ctx := stream.Context()
+	ticker := time.NewTicker(watchInterval)
+	defer ticker.Stop()
+
+	last := grpc_health_v1.HealthCheckResponse_UNKNOWN
+	for first := true; ; first = false {
+		st, ok := s.status(ctx, req.GetService())
+		if !ok {
+			st = grpc_health_v1.HealthCheckResponse_SERVICE_UNKNOWN
+		}
+		// 首次或状态变化时推送
+		if first || st != last {
+			if err := stream.Send(&grpc_health_v1.HealthCheckResponse{Status: st}); err != nil {
+				return status.Error(codes.Canceled, "stream has ended")
+			}
+			last = st
+		}
+		select {
+		case <-ctx.Done():
+			return status.Error(codes.Canceled, "stream has ended")
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *GrpcHealthServer) status(ctx context.Context, service string) (grpc_health_v1.HealthCheckResponse_ServingStatus, bool) {
+	switch service {
+	case "":
+		return toServingStatus(s.health.Readiness(ctx).Healthy()), true
+	case LivenessService:
+		return toServingStatus(s.health.Liveness(ctx).Healthy()), true
+	}
+	// 停机中所有具名检查项都视为不可用
+	if s.health.IsShuttingDown() {
+		return grpc_health_v1.HealthCheckResponse_NOT_SERVING, true
+	}
+	for _, r := range s.health.Readiness(ctx).Checks {
+		if r.Name == service {
+			return toServingStatus(r.Status == StatusUp), true
+		}
+	}
+	return grpc_health_v1.HealthCheckResponse_SERVICE_UNKNOWN, false
+}
+
+func toServingStatus(ok bool) grpc_health_v1.HealthCheckResponse_ServingStatus {
+	if ok {
+		return grpc_health_v1.HealthCheckResponse_SERVING
+	}
+	return grpc_health_v1.HealthCheckResponse_NOT_SERVING
+}
@@ -0,0 +1,328 @@
+// Package health 提供统一的健康检查/就绪检查能力
+//
+// 数据库、redis、consul、client 以及业务代码都可以向 Health 注册具名检查项，
+// 再由 HTTP（/healthz、/readyz）和 gRPC 健康服务统一对外暴露。
+//
+// 检查项分类：
+//   - Liveness: 存活检查，失败表示进程需要被重启（k8s livenessProbe）
+//   - Readiness: 就绪检查，失败表示暂时不能接收流量（k8s readinessProbe、consul check）
+//
+// 每个检查项还可以标记为可选（Optional），可选项失败时只会让状态降级为 DEGRADED，
+// 不会导致整体检查失败。
+package health
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/jaggerzhuang1994/kratos-foundation/pkg/log"
+	"github.com/pkg/errors"
+)
+
+// Kind 检查项类型
+type Kind int
+
+const (
+	// Readiness 就绪检查（默认）
+	Readiness Kind = iota
+	// Liveness 存活检查
+	Liveness
+)
+
+func (k Kind) String() string {
+	if k == Liveness {
+		return "liveness"
+	}
+	return "readiness"
+}
+
+// Status 检查结果状态
+type Status string
+
+const (
+	StatusUp       Status = "UP"       // 全部检查通过
+	StatusDegraded Status = "DEGRADED" // 仅可选检查项失败
+	StatusDown     Status = "DOWN"     // 存在关键检查项失败
+)
+
+// defaultCheckTimeout 单个检查项的默认超时时间
+const defaultCheckTimeout = 3 * time.Second
+
+// shutdownCheckName 停机时就绪检查返回的检查项名称
+const shutdownCheckName = "shutdown"
+
+// CheckFunc 检查函数，返回 nil 表示健康
+type CheckFunc func(ctx context.Context) error
+
+// CheckOption 检查项选项
+type CheckOption func(*check)
+
+// WithLiveness 标记为存活检查（默认为就绪检查）
+func WithLiveness() CheckOption {
+	return func(c *check) {
+		c.kind = Liveness
+	}
+}
+
+// WithOptional 标记为可选检查项，失败时不会导致整体状态为 DOWN
+func WithOptional() CheckOption {
+	return func(c *check) {
+		c.critical = false
+	}
+}
+
+// WithTimeout 设置检查项超时时间，默认 3s
+func WithTimeout(timeout time.Duration) CheckOption {
+	return func(c *check) {
+		if timeout > 0 {
+			c.timeout = timeout
+		}
+	}
+}
+
+// CheckResult 单个检查项的结果
+type CheckResult struct {
+	Name     string `json:"name"`
+	Kind     string `json:"kind"`
+	Critical bool   `json:"critical"`
+	Status   Status `json:"status"`
+	Error    string `json:"error,omitempty"`
+	Duration string `json:"duration"`
+}
+
+// Report 一次检查的汇总结果
+type Report struct {
+	Status Status        `json:"status"`
+	Checks []CheckResult `json:"checks"`
+}
+
+// Healthy 是否可以认为健康（DEGRADED 也视为健康）
+func (r *Report) Healthy() bool {
+	return r.Status != StatusDown
+}
+
+// Health 健康检查注册中心
+type Health interface {
+	// Register 注册检查项，同名检查项会被覆盖
+	Register(name string, fn CheckFunc, opts ...CheckOption)
+	// Unregister 注销检查项
+	Unregister(name string)
+	// Liveness 执行所有存活检查
+	Liveness(ctx context.Context) *Report
+	// Readiness 执行所有存活检查 + 就绪检查（进程不存活也就不可能就绪）
+	Readiness(ctx context.Context) *Report
+	// Shutdown 标记服务进入停机流程，之后就绪检查始终返回 DOWN
+	Shutdown()
+	// IsShuttingDown 是否已进入停机流程
+	IsShuttingDown() bool
+	// OnShutdown 注册进入停机流程时执行的函数，已经在停机流程中时立即执行
+	OnShutdown(fn func())
+}
+
+type check struct {
+	name     string
+	kind     Kind
+	critical bool
+	timeout  time.Duration
+	fn       CheckFunc
+	// failing 上一次检查是否失败，只在状态变化时输出日志，避免探针频繁调用时刷屏
+	failing atomic.Bool
+}
+
+type health struct {
+	log log.Log
+
+	mu     sync.RWMutex
+	checks map[string]*check
+
+	shuttingDown atomic.Bool
+	onShutdown   []func()
+}
+
+func NewHealth(log log.Log) Health {
+	return &health{
+		log:    log.WithModule("health"),
+		checks: map[string]*check{},
+	}
+}
+
+func (h *health) Register(name string, fn CheckFunc, opts ...CheckOption) {
+	if fn == nil {
+		return
+	}
+	c := &check{
+		name:     name,
+		kind:     Readiness,
+		critical: true,
+		timeout:  defaultCheckTimeout,
+		fn:       fn,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if _, ok := h.checks[name]; ok {
+		h.log.Warnf("health check [%s] already registered, overwrite", name)
+	}
+	h.checks[name] = c
+}
+
+func (h *health) Unregister(name string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	delete(h.checks, name)
+}
+
+func (h *health) Liveness(ctx context.Context) *Report {
+	return h.run(ctx, func(c *check) bool {
+		return c.kind == Liveness
+	})
+}
+
+func (h *health) Readiness(ctx context.Context) *Report {
+	if h.IsShuttingDown() {
+		// 停机中直接返回 DOWN，让 k8s/consul 尽快摘除流量
+		return &Report{
+			Status: StatusDown,
+			Checks: []CheckResult{{
+				Name:     shutdownCheckName,
+				Kind:     Readiness.String(),
+				Critical: true,
+				Status:   StatusDown,
+				Error:    "server is shutting down",
+				Duration: "0s",
+			}},
+		}
+	}
+	return h.run(ctx, func(*check) bool {
+		return true
+	})
+}
+
+func (h *health) Shutdown() {
+	h.mu.Lock()
+	if !h.shuttingDown.CompareAndSwap(false, true) {
+		h.mu.Unlock()
+		return
+	}
+	onShutdown := h.onShutdown
+	h.mu.Unlock()
+
+	h.log.Info("server is shutting down, readiness check will fail")
+	for _, fn := range onShutdown {
+		fn()
+	}
+}
+
+func (h *health) OnShutdown(fn func()) {
+	h.mu.Lock()
+	if !h.shuttingDown.Load() {
+		h.onShutdown = append(h.onShutdown, fn)
+		h.mu.Unlock()
+		return
+	}
+	h.mu.Unlock()
+	fn()
+}
+
+func (h *health) IsShuttingDown() bool {
+	return h.shuttingDown.Load()
+}
+
+func (h *health) run(ctx context.Context, filter func(*check) bool) *Report {
+	h.mu.RLock()
+	checks := make([]*check, 0, len(h.checks))
+	for _, c := range h.checks {
+		if filter(c) {
+			checks = append(checks, c)
+		}
+	}
+	h.mu.RUnlock()
+
+	// 并发执行所有检查项
+	results := make([]CheckResult, len(checks))
+	var wg sync.WaitGroup
+	for i, c := range checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = c.run(ctx)
+			h.logTransition(ctx, c, results[i])
+		}()
+	}
+	wg.Wait()
+
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].Name < results[j].Name
+	})
+
+	report := &Report{Status: StatusUp, Checks: results}
+	for _, r := range results {
+		if r.Status == StatusUp {
+			continue
+		}
+		if r.Critical {
+			report.Status = StatusDown
+		} else if report.Status == StatusUp {
+			report.Status = StatusDegraded
+		}
+	}
+	return report
+}
+
+// logTransition 检查项在健康和不健康之间切换时输出日志
+func (h *health) logTransition(ctx context.Context, c *check, r CheckResult) {
+	if r.Status != StatusUp {
+		if !c.failing.Swap(true) {
+			h.log.WithContext(ctx).Warnf("health check [%s] failed: %s", r.Name, r.Error)
+		}
+		return
+	}
+	if c.failing.Swap(false) {
+		h.log.WithContext(ctx).Infof("health check [%s] recovered", r.Name)
+	}
+}
+
+func (c *check) run(ctx context.Context) (result CheckResult) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	start := time.Now()
+	result = CheckResult{
+		Name:     c.name,
+		Kind:     c.kind.String(),
+		Critical: c.critical,
+		Status:   StatusUp,
+	}
+	defer func() {
+		result.Duration = time.Since(start).String()
+	}()
+
+	// 检查函数可能不响应 ctx，这里保证超时后一定返回
+	done := make(chan error, 1)
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				done <- errors.Errorf("panic: %v", r)
+			}
+		}()
+		done <- c.fn(ctx)
+	}()
+
+	var err error
+	select {
+	case err = <-done:
+	case <-ctx.Done():
+		err = errors.WithMessage(ctx.Err(), "health check timeout")
+	}
+	if err != nil {
+		result.Status = StatusDown
+		result.Error = err.Error()
+	}
+	return
+}
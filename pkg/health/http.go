@@ -0,0 +1,35 @@
+package health
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// LivenessHandler 存活检查 HTTP 处理器（/healthz）
+//
+// 检查通过返回 200，否则返回 503，响应体为 JSON 格式的 Report
+func LivenessHandler(health Health) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeReport(w, health.Liveness(r.Context()))
+	})
+}
+
+// ReadinessHandler 就绪检查 HTTP 处理器（/readyz）
+//
+// 检查通过返回 200，否则返回 503，响应体为 JSON 格式的 Report
+func ReadinessHandler(health Health) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeReport(w, health.Readiness(r.Context()))
+	})
+}
+
+func writeReport(w http.ResponseWriter, report *Report) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-store")
+	if report.Healthy() {
+		w.WriteHeader(http.StatusOK)
+	} else {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	_ = json.NewEncoder(w).Encode(report)
+}
@@ -0,0 +1,7 @@
+package health
+
+import "github.com/google/wire"
+
+var ProviderSet = wire.NewSet(
+	NewHealth,
+)
@@ -19,11 +19,17 @@ package job
 
 import (
 	"context"
+	"slices"
+	"sync"
 
+	config2 "github.com/jaggerzhuang1994/kratos-foundation/pkg/config"
 	"github.com/jaggerzhuang1994/kratos-foundation/pkg/job/internal/context"
 	"github.com/jaggerzhuang1994/kratos-foundation/pkg/job/internal/middleware"
 	"github.com/jaggerzhuang1994/kratos-foundation/pkg/job/internal/middleware/concurrent_policy"
 	server2 "github.com/jaggerzhuang1994/kratos-foundation/pkg/server"
+	"github.com/jaggerzhuang1994/kratos-foundation/proto/kratos_foundation_pb/config_pb"
+	"github.com/robfig/cron/v3"
+	"google.golang.org/protobuf/proto"
 )
 
 // Bootstrap 任务启动引导接口
@@ -46,17 +52,26 @@ type Bootstrap any
 //   - serverJobs: 启动时立即执行的异步任务
 //   - cronJobs: 按照 Cron 表达式定时执行的任务
 type server struct {
-	cron Cron // Cron 调度器，管理定时任务
+	log         Log            // 日志记录器
+	cron        Cron           // Cron 调度器，管理定时任务
+	parser      ScheduleParser // Cron 表达式解析器，热更新时重新解析
+	middlewares Middlewares    // 全局任务中间件链，热更新时重新包装任务
 
 	serverJobs []*jobConfig // 异步任务列表，应用启动时立即执行
-	cronJobs   []struct {   // 定时任务列表
-		*jobConfig
-		Schedule
-	}
+	cronJobs   []*cronEntry // 定时任务列表
 
+	mu     sync.Mutex         // 保护 cronJobs 和 ctx
+	ctx    context.Context    // 任务上下文，Start 之后才有值
 	cancel context.CancelFunc // 用于取消所有正在执行的任务
 }
 
+// cronEntry 定时任务及其调度信息
+type cronEntry struct {
+	*jobConfig
+	Schedule
+	id cron.EntryID // 调度器中的任务 ID，Start 之后才有值
+}
+
 // NewBootstrap 创建任务启动引导器
 //
 // 该函数是任务模块的核心入口，负责：
@@ -65,6 +80,7 @@ type server struct {
 //  3. 为每个任务应用中间件链
 //  4. 区分 Cron 任务和 Server 任务
 //  5. 将任务服务器注册到 Kratos 服务器列表
+//  6. 订阅 job 配置段，热更新定时任务的调度
 //
 // 参数说明：
 //   - log: 日志记录器
@@ -74,6 +90,8 @@ type server struct {
 //   - cron: Cron 调度器实例
 //   - parser: Cron 表达式解析器
 //   - serverRegister: Kratos 服务器注册器
+//   - watch: 配置热更新
+//   - defaultConfig: 默认配置，热更新时与新配置合并
 //
 // 返回：
 //   - Bootstrap: 启动引导接口（返回 nil 表示不提供自定义引导）
@@ -86,6 +104,8 @@ type server struct {
 // 注意事项：
 //   - Cron 任务会自动添加并发策略中间件
 //   - Server 任务会在独立的 goroutine 中异步执行
+//   - 热更新只作用于 Cron 任务（schedule、disable、concurrent_policy），
+//     Cron 任务和 Server 任务之间的切换以及 job.disable 需要重启生效
 func NewBootstrap(
 	log Log,
 	config Config,
@@ -94,6 +114,8 @@ func NewBootstrap(
 	cron Cron,
 	parser ScheduleParser,
 	serverRegister server2.Register,
+	watch config2.Watch,
+	defaultConfig DefaultConfig,
 ) (Bootstrap, error) {
 	jlog := log.WithModule("job", config.GetLog())
 	if config.GetDisable() {
@@ -101,10 +123,11 @@ func NewBootstrap(
 		return nil, nil
 	}
 
-	var serverJobs []*jobConfig
-	var cronJobs []struct {
-		*jobConfig
-		Schedule
+	srv := &server{
+		log:         log,
+		cron:        cron,
+		parser:      parser,
+		middlewares: middlewares,
 	}
 
 	for _, jc := range register.getRegisterJobs() {
@@ -112,33 +135,41 @@ func NewBootstrap(
 			continue
 		}
 		if jc.GetSchedule() == "" {
-			serverJobs = append(serverJobs, jc.middleware(middlewares))
+			srv.serverJobs = append(srv.serverJobs, jc.middleware(middlewares))
 		} else {
-			s, err := parser.ParseJob(jc)
+			entry, err := srv.newCronEntry(jc)
 			if err != nil {
 				return nil, err
 			}
-			cronJobs = append(cronJobs, struct {
-				*jobConfig
-				Schedule
-			}{
-				jobConfig: jc.middleware(append([]middleware.Middleware{
-					concurrent_policy.Middleware(log, jc.GetConcurrentPolicy()),
-				}, middlewares...)),
-				Schedule: s,
-			})
+			srv.cronJobs = append(srv.cronJobs, entry)
 		}
 	}
 
-	// 注册为 server
-	serverRegister.RegisterServer(&server{
-		cron:       cron,
-		serverJobs: serverJobs,
-		cronJobs:   cronJobs,
+	// 热更新定时任务
+	watch.Subscribe(config2.SectionJob, func(c, _ config2.KratosFoundationConfig) error {
+		srv.reload(NewConfig(c, defaultConfig), register)
+		return nil
 	})
+
+	// 注册为 server
+	serverRegister.RegisterServer(srv)
 	return nil, nil
 }
 
+// newCronEntry 解析调度表达式，并为任务包装并发策略中间件和全局中间件
+func (s *server) newCronEntry(jc *jobConfig) (*cronEntry, error) {
+	sch, err := s.parser.ParseJob(jc)
+	if err != nil {
+		return nil, err
+	}
+	return &cronEntry{
+		jobConfig: jc.middleware(append([]middleware.Middleware{
+			concurrent_policy.Middleware(s.log, jc.GetConcurrentPolicy()),
+		}, s.middlewares...)),
+		Schedule: sch,
+	}, nil
+}
+
 // Start 启动任务服务器
 //
 // 该方法会：
@@ -154,12 +185,15 @@ func NewBootstrap(
 //   - Server 任务在独立的 goroutine 中执行，不会阻塞服务器启动
 //   - 所有任务都会携带任务名称信息
 func (s *server) Start(ctx context.Context) error {
-	ctx, s.cancel = context.WithCancel(ctx)
+	s.mu.Lock()
+	s.ctx, s.cancel = context.WithCancel(ctx)
+	ctx = s.ctx
 
 	// 注册并启动所有 Cron 任务
 	for _, cjob := range s.cronJobs {
-		s.cron.Schedule(ctx, cjob.name, cjob.job, cjob.Schedule)
+		cjob.id = s.cron.Schedule(ctx, cjob.name, cjob.job, cjob.Schedule)
 	}
+	s.mu.Unlock()
 	s.cron.start()
 
 	// 异步执行所有 Server 任务
@@ -190,3 +224,78 @@ func (s *server) Stop(_ context.Context) error {
 	s.cron.stop()
 	return nil
 }
+
+// reload 根据新配置重新调度 Cron 任务
+//
+// 对比每个注册任务的新旧配置：
+//   - 被禁用或者 schedule 被清空：从调度器移除
+//   - 新增或者配置变化：重新解析调度表达式并替换原任务
+//   - 配置没有变化：保持不变
+//
+// 注意事项：
+//   - 新的调度表达式解析失败时保留原任务
+//   - 重新调度不会触发 immediately
+func (s *server) reload(config Config, register Register) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, registered := range register.getRegisterJobs() {
+		jc := getJobConfig(config, registered.name, registered.job)
+		if slices.ContainsFunc(s.serverJobs, func(sjob *jobConfig) bool {
+			return sjob.name == jc.name
+		}) {
+			if jc.GetSchedule() != "" {
+				s.log.Warnf("job [%s] changed from server job to cron job, restart required", jc.name)
+			}
+			continue
+		}
+
+		i := slices.IndexFunc(s.cronJobs, func(cjob *cronEntry) bool {
+			return cjob.name == jc.name
+		})
+
+		// 禁用或者清空 schedule，移除任务
+		if jc.GetDisable() || jc.GetSchedule() == "" {
+			if i >= 0 {
+				s.removeCronEntry(i)
+				s.log.Infof("job [%s] removed", jc.name)
+			}
+			continue
+		}
+
+		// 配置没有变化（忽略 immediately）
+		if i >= 0 && proto.Equal(withoutImmediately(s.cronJobs[i].JobConfig), withoutImmediately(jc.JobConfig)) {
+			continue
+		}
+
+		jc.JobConfig = withoutImmediately(jc.JobConfig)
+		entry, err := s.newCronEntry(jc)
+		if err != nil {
+			s.log.Errorf("reload job [%s] failed, keep the old schedule: %v", jc.name, err)
+			continue
+		}
+		if i >= 0 {
+			s.removeCronEntry(i)
+		}
+		if s.ctx != nil {
+			entry.id = s.cron.Schedule(s.ctx, entry.name, entry.job, entry.Schedule)
+		}
+		s.cronJobs = append(s.cronJobs, entry)
+		s.log.Infof("job [%s] rescheduled, schedule=%s", jc.name, jc.GetSchedule())
+	}
+}
+
+// removeCronEntry 从调度器和任务列表中移除第 i 个定时任务
+func (s *server) removeCronEntry(i int) {
+	if s.ctx != nil {
+		s.cron.Remove(s.cronJobs[i].id)
+	}
+	s.cronJobs = slices.Delete(s.cronJobs, i, i+1)
+}
+
+// withoutImmediately 复制任务配置并清除 immediately
+func withoutImmediately(c *config_pb.JobConfig) *config_pb.JobConfig {
+	c = proto.CloneOf(c)
+	c.Immediately = nil
+	return c
+}
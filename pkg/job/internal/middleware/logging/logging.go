@@ -12,13 +12,14 @@ func Middleware(log log.Log) middleware.Middleware {
 	return func(next middleware.Handler) middleware.Handler {
 		return func(ctx context.Context) (err error) {
 			st := time.Now()
-			log = log.WithContext(ctx)
+			log := log.WithContext(ctx)
 			log.Info("job execution started")
 			defer func() {
 				if err == nil {
 					log.With("duration", time.Since(st)).Info("job execution done")
 				} else {
-					log.With("duration", time.Since(st)).Error("job execution err done: ", err)
+					// error 作为字段输出，*errors.Error 由日志器展开为 code、reason、metadata、causes、stack 等结构化字段
+					log.With("duration", time.Since(st), "error", err).Error("job execution err done")
 				}
 			}()
 			err = next(ctx)
@@ -0,0 +1,102 @@
+package log
+
+import (
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/jaggerzhuang1994/kratos-foundation/internal/logger"
+	"github.com/jaggerzhuang1994/kratos-foundation/proto/kratos_foundation_pb/config_pb"
+)
+
+// 输出目标名称
+const (
+	SinkStd  = "std"
+	SinkFile = "file"
+)
+
+// AsyncStats 异步写入的输出目标状态
+type AsyncStats struct {
+	Sink     string // 输出目标，std、file 或 file:{path}（log.files）
+	Depth    int    // 缓冲区中等待写入的日志条数
+	Capacity int    // 缓冲区大小
+	Dropped  int64  // 累计丢弃的日志条数（配置热更新后继续累加）
+}
+
+// AsyncStatsProvider 提供异步写入的输出目标状态，用于上报指标
+type AsyncStatsProvider interface {
+	// AsyncStats 按输出目标名称排序，开启过异步写入的输出目标都会返回
+	AsyncStats() []AsyncStats
+}
+
+// asyncSinks 异步写入的输出目标，所有 logger 实例共享
+type asyncSinks struct {
+	mu      sync.RWMutex
+	sinks   map[string]*internal_logger.AsyncLogger
+	dropped map[string]*atomic.Int64
+}
+
+// droppedCounter 输出目标的丢弃计数，不存在时创建
+func (s *asyncSinks) droppedCounter(sink string) *atomic.Int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.dropped == nil {
+		s.dropped = map[string]*atomic.Int64{}
+	}
+	if _, ok := s.dropped[sink]; !ok {
+		s.dropped[sink] = new(atomic.Int64)
+	}
+	return s.dropped[sink]
+}
+
+func (s *asyncSinks) store(sinks map[string]*internal_logger.AsyncLogger) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sinks = sinks
+}
+
+func (s *asyncSinks) stats() []AsyncStats {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	stats := make([]AsyncStats, 0, len(s.dropped))
+	for sink, dropped := range s.dropped {
+		st := AsyncStats{Sink: sink, Dropped: dropped.Load()}
+		if a, ok := s.sinks[sink]; ok {
+			st.Depth = a.Depth()
+			st.Capacity = a.Capacity()
+		}
+		stats = append(stats, st)
+	}
+	slices.SortFunc(stats, func(a, b AsyncStats) int {
+		return strings.Compare(a.Sink, b.Sink)
+	})
+	return stats
+}
+
+// AsyncStats 实现 AsyncStatsProvider
+func (l *logger) AsyncStats() []AsyncStats {
+	return l.async.stats()
+}
+
+// withAsync 按配置将输出目标包装为异步写入，释放时先写完缓冲区再释放输出目标
+func (l *logger) withAsync(sink string, sinkLogger log.Logger, rc func(), c *config_pb.LogAsync, sinks map[string]*internal_logger.AsyncLogger) (log.Logger, func()) {
+	if c == nil || c.GetDisable() {
+		return sinkLogger, rc
+	}
+	asyncLogger, asyncRc := internal_logger.NewAsyncLogger(sinkLogger, internal_logger.AsyncLoggerConfig{
+		BufferSize:      int(c.GetBufferSize()),
+		Overflow:        c.GetOverflow(),
+		NeverDropErrors: c.GetNeverDropErrors(),
+		FlushTimeout:    c.GetFlushTimeout().AsDuration(),
+		Dropped:         l.async.droppedCounter(sink),
+	})
+	sinks[sink] = asyncLogger
+	return asyncLogger, func() {
+		asyncRc()
+		if rc != nil {
+			rc()
+		}
+	}
+}
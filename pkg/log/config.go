@@ -3,9 +3,11 @@ package log
 import (
 	"time"
 
+	"github.com/go-kratos/kratos/v2/log"
 	"github.com/jaggerzhuang1994/kratos-foundation/pkg/env"
 	"github.com/jaggerzhuang1994/kratos-foundation/proto/kratos_foundation_pb/config_pb"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
 type Config = *config_pb.Log
@@ -17,6 +19,11 @@ func NewDefaultConfig() DefaultConfig {
 	if env.AppDebug() || env.IsLocal() {
 		defaultLevel = "debug"
 	}
+	// 本地环境标准输出使用便于阅读的 console 编码
+	var defaultStdEncoding = "logfmt"
+	if env.IsLocal() {
+		defaultStdEncoding = "console"
+	}
 	return &config_pb.Log{
 		Level:       proto.String(defaultLevel),
 		FilterEmpty: proto.Bool(true),
@@ -28,6 +35,12 @@ func NewDefaultConfig() DefaultConfig {
 			FilterKeys: []string{
 				"service.id", "service.name", "service.version",
 			},
+			Async:    newDefaultAsyncConfig(),
+			Encoding: proto.String(defaultStdEncoding),
+			Encoder: &config_pb.LogEncoder{
+				LevelCase: proto.String("upper"),
+				Color:     proto.Bool(true),
+			},
 		},
 		File: &config_pb.FileLogger{
 			Disable:    proto.Bool(false),
@@ -41,8 +54,62 @@ func NewDefaultConfig() DefaultConfig {
 				MaxFiles:   proto.Int32(0),
 				LocalTime:  proto.Bool(false),
 				Compress:   proto.Bool(false),
+				Interval:   proto.String("none"),
+				Pattern:    nil, // 空表示按 interval 使用默认的文件名
+			},
+			Async:    newDefaultAsyncConfig(),
+			Encoding: proto.String("json"),
+			Encoder: &config_pb.LogEncoder{
+				LevelCase: proto.String("upper"),
+				Color:     proto.Bool(false),
 			},
 		},
 		Preset: []string{}, // 空表示所有
+		Sampling: &config_pb.LogSampling{
+			Disable:         proto.Bool(true),
+			Interval:        durationpb.New(time.Second),
+			First:           proto.Int32(100),
+			Thereafter:      proto.Int32(100),
+			SummaryInterval: durationpb.New(10 * time.Second),
+			Keys:            nil, // 空表示 defaultSamplingKeys
+		},
+		Otlp: &config_pb.OtlpLogger{
+			Disable: proto.Bool(true),
+			Level:   proto.String(defaultLevel),
+			FilterKeys: []string{
+				"service.id", "service.name", "service.version",
+			},
+			Exporter: &config_pb.Exporter{
+				EndpointUrl: proto.String("http://localhost:4318/v1/logs"),
+				Compression: config_pb.Exporter_NO.Enum(),
+				Headers:     nil,
+				Timeout:     durationpb.New(10 * time.Second),
+				Retry: &config_pb.Exporter_RetryConfig{
+					Enabled:         proto.Bool(true),
+					InitialInterval: durationpb.New(5 * time.Second),
+					MaxInterval:     durationpb.New(30 * time.Second),
+					MaxElapsedTime:  durationpb.New(time.Minute),
+				},
+			},
+			Batch: &config_pb.OtlpLogBatch{
+				MaxQueueSize:       proto.Int32(2048),
+				ExportInterval:     durationpb.New(time.Second),
+				MaxExportBatchSize: proto.Int32(512),
+			},
+		},
+	}
+}
+
+// defaultSamplingKeys 默认判断日志是否相同的 key
+var defaultSamplingKeys = []string{moduleKey, log.DefaultMessageKey}
+
+// newDefaultAsyncConfig 默认的异步写入配置（默认禁用）
+func newDefaultAsyncConfig() *config_pb.LogAsync {
+	return &config_pb.LogAsync{
+		Disable:         proto.Bool(true),
+		BufferSize:      proto.Int32(4096),
+		Overflow:        proto.String("drop_newest"),
+		NeverDropErrors: proto.Bool(true),
+		FlushTimeout:    durationpb.New(5 * time.Second),
 	}
 }
@@ -0,0 +1,69 @@
+package log
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/jaggerzhuang1994/kratos-foundation/pkg/errors"
+	pkgerrors "github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestErrorLogger(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "app.log")
+	config := NewDefaultConfig()
+	config.Std.Disable = proto.Bool(true)
+	config.File.Path = proto.String(path)
+
+	logger, release, err := NewLogger(PresetKv{}, config, NewHook(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	se := errors.New(400, "VALIDATOR", "invalid request").
+		WithMetadata(map[string]string{"field": "name"}).
+		WithReasonCode(400001).
+		WithCause(pkgerrors.WithMessage(pkgerrors.New("name is empty"), "validate")).
+		WithErrStack()
+	_ = logger.Log(log.LevelError, log.DefaultMessageKey, "request failed", "error", pkgerrors.WithMessage(se, "handler"), "plain", pkgerrors.New("plain error"))
+	release()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var entry struct {
+		Plain string `json:"plain"`
+		Error struct {
+			Code       int               `json:"code"`
+			Reason     string            `json:"reason"`
+			ReasonCode int               `json:"reason_code"`
+			Message    string            `json:"message"`
+			Metadata   map[string]string `json:"metadata"`
+			Causes     []string          `json:"causes"`
+			Stack      []string          `json:"stack"`
+		} `json:"error"`
+	}
+	if err = json.Unmarshal(data, &entry); err != nil {
+		t.Fatalf("%v: %s", err, data)
+	}
+	if entry.Error.Code != 400 || entry.Error.Reason != "VALIDATOR" || entry.Error.ReasonCode != 400001 || entry.Error.Message != "invalid request" {
+		t.Errorf("error = %+v", entry.Error)
+	}
+	// err_stack 和 reason_code 等内部 key 不在 metadata 中输出
+	if len(entry.Error.Metadata) != 1 || entry.Error.Metadata["field"] != "name" {
+		t.Errorf("metadata = %v", entry.Error.Metadata)
+	}
+	if strings.Join(entry.Error.Causes, "|") != "validate: name is empty|name is empty" {
+		t.Errorf("causes = %v", entry.Error.Causes)
+	}
+	if len(entry.Error.Stack) == 0 || !strings.Contains(entry.Error.Stack[0], "TestErrorLogger") {
+		t.Errorf("stack = %v", entry.Error.Stack)
+	}
+	if entry.Plain != "plain error" {
+		t.Errorf("plain = %s", entry.Plain)
+	}
+}
@@ -67,29 +67,13 @@ func (l *helper) With(kv ...any) Log {
 }
 
 func (l *helper) WithModule(module string, optionalModuleLog ...ModuleConfig) Log {
-	if len(optionalModuleLog) == 0 {
-		return l.With(moduleKey, module)
+	var moduleConfig ModuleConfig
+	if len(optionalModuleLog) > 0 {
+		moduleConfig = optionalModuleLog[0]
 	}
-
-	moduleConfig := optionalModuleLog[0]
-	if moduleConfig.GetDisable() {
-		return &helper{
-			log.NewHelper(l.getLogger().Disable()),
-		}
-	}
-
-	if moduleConfig.GetLevel() != "" {
-		level := log.ParseLevel(moduleConfig.GetLevel())
-		return &helper{
-			log.NewHelper(
-				l.getLogger().With(moduleKey, module).FilterLevel(level).FilterKeys(moduleConfig.GetFilterKeys()...),
-			),
-		}
-	}
-
 	return &helper{
 		log.NewHelper(
-			l.getLogger().With(moduleKey, module).FilterKeys(moduleConfig.GetFilterKeys()...),
+			l.getLogger().WithModule(module, moduleConfig),
 		),
 	}
 }
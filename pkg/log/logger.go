@@ -8,7 +8,10 @@ import (
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/jaggerzhuang1994/kratos-foundation/internal/logger"
+	"github.com/jaggerzhuang1994/kratos-foundation/pkg/app_info"
 	"github.com/jaggerzhuang1994/kratos-foundation/pkg/utils"
+	"github.com/jaggerzhuang1994/kratos-foundation/proto/kratos_foundation_pb/config_pb"
+	"github.com/pkg/errors"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -23,6 +26,8 @@ type UpdateLogger interface {
 type Logger interface {
 	log.Logger
 	UpdateLogger
+	AsyncStatsProvider
+	ModuleLevels
 	// Disable 禁用或启用日志输出，可选参数指定是否禁用（默认 true）
 	// 返回新的 Logger 实例，不影响原实例
 	Disable(...bool) Logger
@@ -35,6 +40,9 @@ type Logger interface {
 	// FilterKeys 设置需要过滤的键列表，这些键不会被记录到日志中
 	// 返回新的 Logger 实例，不影响原实例
 	FilterKeys(keys ...string) Logger
+	// WithModule 绑定模块，按模块配置过滤级别和 key，模块级别可以通过 SetModuleLevel 临时调整
+	// 返回新的 Logger 实例，不影响原实例
+	WithModule(module string, config ModuleConfig) Logger
 	// WithContext 将 context 关联到 logger，用于追踪链路信息
 	// 返回新的 Logger 实例，不影响原实例
 	WithContext(context.Context) Logger
@@ -82,16 +90,20 @@ type cacheLogger struct {
 // logger Logger 接口的实现，支持动态配置和链式调用
 // 采用写时复制（copy-on-write）模式，每次 With/FilterKeys 等操作返回新实例
 type logger struct {
-	global        *atomic.Value // 存储 *globalLogger，保证并发安全
-	release       *atomic.Value // 存储资源释放函数 func()
-	presetKv      PresetKv      // 预设键值对生成器
-	defaultConfig DefaultConfig // 默认配置
-	hook          Hook          // 日志钩子
+	global            *atomic.Value              // 存储 *globalLogger，保证并发安全
+	release           *atomic.Value              // 存储资源释放函数 func()
+	async             *asyncSinks                // 异步写入的输出目标
+	modules           *moduleLevels              // 模块日志级别
+	presetKv          PresetKv                   // 预设键值对生成器
+	defaultConfig     DefaultConfig              // 默认配置
+	hook              Hook                       // 日志钩子
+	serviceAttributes app_info.ServiceAttributes // 服务属性，作为 otlp 日志的 resource 属性
 
 	cache atomic.Value // 存储 *cacheLogger，缓存分层日志器
 
 	disable     bool                // 是否禁用日志
 	level       *log.Level          // 实例级别的日志过滤级别（nil 表示使用全局级别）
+	module      *moduleLevel        // 绑定的模块，临时调整的级别优先于 level
 	filterKeys  map[string]struct{} // 实例级别的键过滤
 	callerDepth int                 // 实例级别的调用栈深度
 	kv          []any               // 实例级别的键值对
@@ -103,6 +115,7 @@ type logger struct {
 //   - presetKv: 预设键值对生成器，用于生成日志公共字段
 //   - defaultConfig: 默认日志配置
 //   - hook: 日志生命周期钩子
+//   - serviceAttributes: 服务属性，otlp 日志上报的 resource 属性
 //
 // 返回：
 //   - Logger: 日志实例
@@ -112,20 +125,25 @@ func NewLogger(
 	presetKv PresetKv,
 	defaultConfig DefaultConfig,
 	hook Hook,
+	serviceAttributes app_info.ServiceAttributes,
 ) (Logger, func(), error) {
 	l := &logger{
-		global:        new(atomic.Value),
-		release:       new(atomic.Value),
-		presetKv:      presetKv,
-		defaultConfig: defaultConfig,
-		hook:          hook,
-		cache:         atomic.Value{},
-		disable:       false,
-		level:         nil,
-		filterKeys:    nil,
-		callerDepth:   0,
-		kv:            nil,
-		ctx:           nil,
+		global:            new(atomic.Value),
+		release:           new(atomic.Value),
+		async:             new(asyncSinks),
+		modules:           new(moduleLevels),
+		presetKv:          presetKv,
+		defaultConfig:     defaultConfig,
+		hook:              hook,
+		serviceAttributes: serviceAttributes,
+		cache:             atomic.Value{},
+		disable:           false,
+		level:             nil,
+		module:            nil,
+		filterKeys:        nil,
+		callerDepth:       0,
+		kv:                nil,
+		ctx:               nil,
 	}
 	// 使用默认 logger
 	l.global.Store(&globalLogger{
@@ -151,7 +169,7 @@ func NewLogger(
 // Log 实现 log.Logger 接口，输出日志
 // 执行流程：
 // 1. 检查是否禁用
-// 2. 检查日志级别
+// 2. 检查日志级别（模块临时调整的级别优先），低于级别但不低于请求日志级别（见 NewLevelContext）时强制输出
 // 3. 检查缓存有效性，必要时重建缓存
 // 4. 委托给缓存的日志器执行
 func (l *logger) Log(level log.Level, keyvals ...any) error {
@@ -159,16 +177,28 @@ func (l *logger) Log(level log.Level, keyvals ...any) error {
 	if l.disable {
 		return nil
 	}
-	// 优先过滤 l.level（实例级别）
+	global := l.global.Load().(*globalLogger)
+	// 优先使用 l.level（实例级别），未设置时使用全局级别
+	minLevel := global.level
 	if l.level != nil {
-		if level < *l.level {
+		minLevel = *l.level
+	}
+	force := false
+	// 模块临时调整的级别低于配置的级别时，同样需要跳过各输出目标的级别过滤
+	if moduleLevel, ok := l.module.level(); ok {
+		force = level >= moduleLevel && level < minLevel
+		minLevel = moduleLevel
+	}
+	if level < minLevel {
+		requestLevel, ok := LevelFromContext(l.ctx)
+		if !ok || level < requestLevel {
 			return nil
 		}
+		force = true
 	}
-	global := l.global.Load().(*globalLogger)
-	// 如果实例级别未设置，则使用全局级别
-	if l.level == nil && level < global.level {
-		return nil
+	if force {
+		// 标记后跳过各输出目标的级别过滤
+		keyvals = internal_logger.WithForceLevel(keyvals)
 	}
 
 	// 检查缓存是否过期，global.timestamp 变更表示配置已更新
@@ -414,10 +444,7 @@ func (l *logger) buildCache() {
 			layer1 = l.cacheLayer1(global, cache)
 			layer2 = l.cacheLayer2(global, cache)
 			layer3 = l.cacheLayer3(global, cache)
-			layer4 = cache.Logger
-			if layer4 == nil {
-				layer4 = l.buildLayer4(layer3)
-			}
+			layer4 = l.buildLayer4(layer3)
 		} else {
 			// 无变化，复用所有层
 			layer1 = l.cacheLayer1(global, cache)
@@ -469,7 +496,7 @@ func (l *logger) buildCache() {
 // 3. 为每个日志器应用键过滤和级别过滤
 // 4. 合并所有日志器为统一的 coreLogger
 // 5. 添加预设 KV（时间戳、调用者等）
-// 6. 释放旧的日志器资源，保存新的全局配置
+// 6. 保存新的全局配置，释放旧的日志器资源
 func (l *logger) Update(c Config) error {
 	// 合并默认配置和传入配置
 	config := proto.CloneOf((Config)(l.defaultConfig))
@@ -477,45 +504,31 @@ func (l *logger) Update(c Config) error {
 
 	var loggers []log.Logger
 	var rcs []func()
+	asyncLoggers := map[string]*internal_logger.AsyncLogger{}
 
 	// 创建文件日志器
-	if file := config.GetFile(); !file.GetDisable() {
-		// 内部文件日志配置
-		fileLoggerConf := internal_logger.FileLoggerConfig{
-			Path:     file.GetPath(),
-			Rotating: nil,
-		}
-		// 文件轮换配置
-		if rotating := file.GetRotating(); !rotating.GetDisable() {
-			fileLoggerConf.Rotating = &internal_logger.RotatingFileLoggerConfig{
-				MaxSize:    int(rotating.GetMaxSize()),
-				MaxFileAge: int(rotating.GetMaxFileAge()),
-				MaxFiles:   int(rotating.GetMaxFiles()),
-				LocalTime:  rotating.GetLocalTime(),
-				Compress:   rotating.GetCompress(),
-			}
-		}
-
-		fileLogger, rc, err := internal_logger.NewFileLogger(fileLoggerConf)
+	fileSinks, err := l.fileSinks(config)
+	if err != nil {
+		return err
+	}
+	for _, sink := range fileSinks {
+		fileLogger, rc, err := l.newFileLogger(config, sink, asyncLoggers)
 		if err != nil {
+			for _, rc := range rcs {
+				rc()
+			}
 			return err
 		}
 		rcs = append(rcs, rc)
-
-		// 应用键过滤和级别过滤
-		fileLogger = internal_logger.NewFilterLogger(fileLogger, false, filterKeys(file.GetFilterKeys()))
-
-		level := log.LevelDebug
-		if file != nil && file.Level != nil {
-			level = log.ParseLevel(file.GetLevel())
-		}
-		fileLogger = internal_logger.NewFilterLevelLogger(fileLogger, level)
 		loggers = append(loggers, fileLogger)
 	}
 
 	// 创建标准输出日志器
 	if std := config.GetStd(); !std.GetDisable() {
-		stdLogger := internal_logger.NewStdLogger()
+		stdLogger, rc := l.withAsync(SinkStd, internal_logger.NewStdLogger(encoderConfig(config, std.GetEncoding(), std.GetEncoder())), nil, std.GetAsync(), asyncLoggers)
+		if rc != nil {
+			rcs = append(rcs, rc)
+		}
 		stdLogger = internal_logger.NewFilterLogger(stdLogger, false, filterKeys(std.GetFilterKeys()))
 		level := log.LevelDebug
 		if std != nil && std.Level != nil {
@@ -525,10 +538,46 @@ func (l *logger) Update(c Config) error {
 		loggers = append(loggers, stdLogger)
 	}
 
+	// 创建 otlp 日志器
+	if otlp := config.GetOtlp(); otlp != nil && !otlp.GetDisable() {
+		otlpLogger, rc, err := l.newOtlpLogger(otlp)
+		if err != nil {
+			for _, rc := range rcs {
+				rc()
+			}
+			return err
+		}
+		rcs = append(rcs, rc)
+		otlpLogger = internal_logger.NewFilterLogger(otlpLogger, false, filterKeys(otlp.GetFilterKeys()))
+		level := log.LevelDebug
+		if otlp.Level != nil {
+			level = log.ParseLevel(otlp.GetLevel())
+		}
+		otlpLogger = internal_logger.NewFilterLevelLogger(otlpLogger, level)
+		loggers = append(loggers, otlpLogger)
+	}
+
 	// 合并所有日志器为 coreLogger
 	coreLogger := internal_logger.NewStackLogger(loggers...)
 	coreLogger = internal_logger.NewFilterLogger(coreLogger, config.GetFilterEmpty(), filterKeys(config.GetFilterKeys()))
-	logger := coreLogger
+	// 配置中解析出来的敏感值不允许出现在日志中
+	coreLogger = internal_logger.NewSecretLogger(coreLogger)
+	// 按规则脱敏
+	coreLogger, err = internal_logger.NewRedactLogger(coreLogger, utils.Map(config.GetRedactRules(), func(rule *config_pb.LogRedactRule) internal_logger.RedactRule {
+		return internal_logger.RedactRule{
+			Keys:   rule.GetKeys(),
+			Values: rule.GetValues(),
+			Mask:   rule.GetMask(),
+		}
+	}), config.GetRedactHashKey())
+	if err != nil {
+		for _, rc := range rcs {
+			rc()
+		}
+		return err
+	}
+	// *errors.Error 展开为结构化字段，在脱敏之前展开，保证 metadata 和 causes 同样被脱敏
+	coreLogger = internal_logger.NewErrorLogger(coreLogger)
 
 	// 添加预设 KV
 	var kv []any
@@ -543,24 +592,40 @@ func (l *logger) Update(c Config) error {
 		if !ok {
 			continue
 		}
-		// 时间戳字段支持自定义格式
-		if key == tsKey {
-			if config.GetTimeFormat() != "" {
-				kv = append(kv, key, log.Timestamp(config.GetTimeFormat()))
-			} else {
-				kv = append(kv, key, val)
-			}
-		} else {
-			if key == callerKey {
-				hasCaller = true
-			}
-			kv = append(kv, key, val)
+		// 时间戳字段的格式由各输出目标的编码配置决定（默认为 log.time_format）
+		if key == callerKey {
+			hasCaller = true
 		}
+		kv = append(kv, key, val)
 	}
 
 	// 添加 hook 的全局 KV
 	kv = append(kv, l.hook.GetKv()...)
-	logger = log.With(logger, kv...)
+
+	// 日志采样，放在预设 KV 之前，汇总日志单独携带预设 KV（调用者信息没有意义，去掉）
+	if sampling := config.GetSampling(); sampling != nil && !sampling.GetDisable() {
+		keys := sampling.GetKeys()
+		if len(keys) == 0 {
+			keys = defaultSamplingKeys
+		}
+		var summaryKv []any
+		for i := 0; i+1 < len(kv); i += 2 {
+			if kv[i] != callerKey {
+				summaryKv = append(summaryKv, kv[i], kv[i+1])
+			}
+		}
+		var rc func()
+		coreLogger, rc = internal_logger.NewSamplingLogger(coreLogger, internal_logger.SamplingLoggerConfig{
+			Interval:        sampling.GetInterval().AsDuration(),
+			First:           int(sampling.GetFirst()),
+			Thereafter:      int(sampling.GetThereafter()),
+			SummaryInterval: sampling.GetSummaryInterval().AsDuration(),
+			Keys:            utils.Unique(keys),
+			SummaryKv:       summaryKv,
+		})
+		rcs = append(rcs, rc)
+	}
+	logger := log.With(coreLogger, kv...)
 	kvLogger := logger
 
 	// 应用全局日志级别过滤
@@ -570,16 +635,6 @@ func (l *logger) Update(c Config) error {
 	}
 	logger = log.NewFilter(logger, log.FilterLevel(level))
 
-	// 释放旧的日志器资源（关闭文件句柄等）
-	old := l.release.Swap(func() {
-		for _, rc := range rcs {
-			rc()
-		}
-	})
-	if old != nil {
-		old.(func())()
-	}
-
 	// 保存新的全局配置
 	l.global.Store(&globalLogger{
 		timestamp:  time.Now(),
@@ -591,9 +646,113 @@ func (l *logger) Update(c Config) error {
 		hasValuer:  containsValuer(kv),
 		hasCaller:  hasCaller,
 	})
+	l.async.store(asyncLoggers)
+
+	// 切换到新的日志器后，再释放旧的日志器资源（写完异步缓冲区、关闭文件句柄等）
+	old := l.release.Swap(func() {
+		for _, rc := range rcs {
+			rc()
+		}
+	})
+	if old != nil {
+		old.(func())()
+	}
 	return nil
 }
 
+// fileSink 文件日志的输出目标
+type fileSink struct {
+	name   string // 输出目标名称，log.file 为 file，log.files 为 file:{path}
+	config *config_pb.FileLogger
+}
+
+// fileSinks 启用的文件日志，log.files 未配置的字段使用 log.file 的默认配置
+func (l *logger) fileSinks(config Config) ([]fileSink, error) {
+	var sinks []fileSink
+	paths := map[string]struct{}{}
+	if file := config.GetFile(); !file.GetDisable() {
+		sinks = append(sinks, fileSink{SinkFile, file})
+		paths[file.GetPath()] = struct{}{}
+	}
+	for i, item := range config.GetFiles() {
+		file := proto.CloneOf((Config)(l.defaultConfig).GetFile())
+		file.Path = nil
+		proto.Merge(file, item)
+		if file.GetDisable() {
+			continue
+		}
+		if file.GetPath() == "" {
+			return nil, errors.Errorf("log.files[%d].path is required", i)
+		}
+		if _, ok := paths[file.GetPath()]; ok {
+			return nil, errors.Errorf("log.files[%d].path %q is duplicated", i, file.GetPath())
+		}
+		paths[file.GetPath()] = struct{}{}
+		sinks = append(sinks, fileSink{SinkFile + ":" + file.GetPath(), file})
+	}
+	return sinks, nil
+}
+
+// newFileLogger 创建文件日志器，并应用异步写入、键过滤和级别过滤
+func (l *logger) newFileLogger(config Config, sink fileSink, asyncLoggers map[string]*internal_logger.AsyncLogger) (log.Logger, func(), error) {
+	file := sink.config
+	// 内部文件日志配置
+	fileLoggerConf := internal_logger.FileLoggerConfig{
+		Path:     file.GetPath(),
+		Rotating: nil,
+		Encoder:  encoderConfig(config, file.GetEncoding(), file.GetEncoder()),
+	}
+	// 文件轮换配置
+	if rotating := file.GetRotating(); !rotating.GetDisable() {
+		fileLoggerConf.Rotating = &internal_logger.RotatingFileLoggerConfig{
+			MaxSize:    int(rotating.GetMaxSize()),
+			MaxFileAge: int(rotating.GetMaxFileAge()),
+			MaxFiles:   int(rotating.GetMaxFiles()),
+			LocalTime:  rotating.GetLocalTime(),
+			Compress:   rotating.GetCompress(),
+			Interval:   rotating.GetInterval(),
+			Pattern:    rotating.GetPattern(),
+		}
+	}
+
+	fileLogger, rc, err := internal_logger.NewFileLogger(fileLoggerConf)
+	if err != nil {
+		return nil, nil, errors.WithMessagef(err, "new %s logger", sink.name)
+	}
+	fileLogger, rc = l.withAsync(sink.name, fileLogger, rc, file.GetAsync(), asyncLoggers)
+
+	// 应用键过滤和级别过滤
+	fileLogger = internal_logger.NewFilterLogger(fileLogger, false, filterKeys(file.GetFilterKeys()))
+
+	level := log.LevelDebug
+	if file.Level != nil {
+		level = log.ParseLevel(file.GetLevel())
+	}
+	maxLevel := log.LevelFatal
+	if file.MaxLevel != nil {
+		maxLevel = log.ParseLevel(file.GetMaxLevel())
+	}
+	// log.files 为按级别分流的输出目标，按请求或模块强制输出的日志同样按 level 过滤，只有 log.file 跳过
+	return internal_logger.NewFilterLevelRangeLogger(fileLogger, level, maxLevel, sink.name == SinkFile), rc, nil
+}
+
+// encoderConfig 输出目标的编码配置，时间格式默认为 log.time_format
+func encoderConfig(config Config, encoding string, encoder *config_pb.LogEncoder) internal_logger.EncoderConfig {
+	timeFormat := encoder.GetTimeFormat()
+	if timeFormat == "" {
+		timeFormat = config.GetTimeFormat()
+	}
+	return internal_logger.EncoderConfig{
+		Encoding:   encoding,
+		TimeKey:    tsKey,
+		TimeFormat: timeFormat,
+		MessageKey: encoder.GetMessageKey(),
+		LevelKey:   encoder.GetLevelKey(),
+		LevelCase:  encoder.GetLevelCase(),
+		Color:      encoder.GetColor(),
+	}
+}
+
 // filterKeys 将字符串切片转换为 map[string]struct{}，用于高效的键查找和过滤
 // 使用空结构体作为值可以减少内存占用
 func filterKeys(filterKeys []string) map[string]struct{} {
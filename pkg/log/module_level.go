@@ -0,0 +1,194 @@
+package log
+
+import (
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/pkg/errors"
+)
+
+// DefaultModuleLevelTTL 临时调整模块日志级别的默认有效期
+const DefaultModuleLevelTTL = 10 * time.Minute
+
+// ModuleLevel 模块日志级别
+type ModuleLevel struct {
+	Module      string     `json:"module"`
+	Disable     bool       `json:"disable,omitempty"`      // 模块日志被配置禁用，不能调整级别
+	ConfigLevel string     `json:"config_level,omitempty"` // 配置的级别，为空时使用全局级别
+	Level       string     `json:"level,omitempty"`        // 临时调整的级别，为空时表示没有调整
+	ExpiresAt   *time.Time `json:"expires_at,omitempty"`   // 临时调整的过期时间，过期后恢复配置的级别
+}
+
+// ModuleLevels 通过 WithModule 创建的模块日志级别，可以在运行时临时调整
+type ModuleLevels interface {
+	// ModuleLevels 按模块名称排序
+	ModuleLevels() []ModuleLevel
+	// SetModuleLevel 临时调整模块日志级别，ttl 后恢复配置的级别，ttl 不大于 0 时为 DefaultModuleLevelTTL
+	SetModuleLevel(module string, level log.Level, ttl time.Duration) (ModuleLevel, error)
+	// ResetModuleLevel 取消临时调整，恢复配置的级别
+	ResetModuleLevel(module string) (ModuleLevel, error)
+	// ModuleOverrideLevel 模块临时调整的级别，没有调整、已经过期或者模块不存在时返回 false
+	ModuleOverrideLevel(module string) (log.Level, bool)
+}
+
+// moduleOverride 临时调整的级别
+type moduleOverride struct {
+	level     log.Level
+	expiresAt time.Time
+}
+
+// moduleLevel 一个模块的日志级别，同名模块共享
+type moduleLevel struct {
+	name        string
+	configured  bool // 已经按模块配置注册过，由 moduleLevels.mu 保护
+	disable     atomic.Bool
+	configLevel atomic.Pointer[log.Level]
+	override    atomic.Pointer[moduleOverride]
+}
+
+// level 临时调整的级别，没有调整或者已经过期时返回 false
+func (m *moduleLevel) level() (log.Level, bool) {
+	if m == nil {
+		return 0, false
+	}
+	o := m.override.Load()
+	if o == nil {
+		return 0, false
+	}
+	if time.Now().After(o.expiresAt) {
+		m.override.CompareAndSwap(o, nil)
+		return 0, false
+	}
+	return o.level, true
+}
+
+func (m *moduleLevel) info() ModuleLevel {
+	info := ModuleLevel{Module: m.name, Disable: m.disable.Load()}
+	if configLevel := m.configLevel.Load(); configLevel != nil {
+		info.ConfigLevel = strings.ToLower(configLevel.String())
+	}
+	if level, ok := m.level(); ok {
+		info.Level = strings.ToLower(level.String())
+		if o := m.override.Load(); o != nil {
+			expiresAt := o.expiresAt
+			info.ExpiresAt = &expiresAt
+		}
+	}
+	return info
+}
+
+// moduleLevels 模块日志级别注册中心，所有 logger 实例共享
+type moduleLevels struct {
+	mu      sync.RWMutex
+	modules map[string]*moduleLevel
+}
+
+// register 注册模块，同名模块以第一次配置了级别或禁用的注册为准
+//
+// 同一个模块可能在多处注册（例如 WithModule("job", nil) 和 WithModule("job", config.GetLog())），
+// 没有配置（包括 nil 和值为 nil 的 *ModuleLog）的注册不能覆盖已经配置的级别
+func (s *moduleLevels) register(name string, config ModuleConfig) *moduleLevel {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.modules == nil {
+		s.modules = map[string]*moduleLevel{}
+	}
+	m, ok := s.modules[name]
+	if !ok {
+		m = &moduleLevel{name: name}
+		s.modules[name] = m
+	}
+	if config == nil || m.configured || (config.GetLevel() == "" && !config.GetDisable()) {
+		return m
+	}
+	m.configured = true
+	if config.GetLevel() != "" {
+		level := log.ParseLevel(config.GetLevel())
+		m.configLevel.Store(&level)
+	}
+	m.disable.Store(config.GetDisable())
+	return m
+}
+
+func (s *moduleLevels) get(name string) (*moduleLevel, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	m, ok := s.modules[name]
+	if !ok {
+		return nil, errors.Errorf("unknown log module %q", name)
+	}
+	return m, nil
+}
+
+// ModuleLevels 实现 ModuleLevels
+func (l *logger) ModuleLevels() []ModuleLevel {
+	l.modules.mu.RLock()
+	defer l.modules.mu.RUnlock()
+	levels := make([]ModuleLevel, 0, len(l.modules.modules))
+	for _, m := range l.modules.modules {
+		levels = append(levels, m.info())
+	}
+	slices.SortFunc(levels, func(a, b ModuleLevel) int {
+		return strings.Compare(a.Module, b.Module)
+	})
+	return levels
+}
+
+// SetModuleLevel 实现 ModuleLevels
+func (l *logger) SetModuleLevel(module string, level log.Level, ttl time.Duration) (ModuleLevel, error) {
+	m, err := l.modules.get(module)
+	if err != nil {
+		return ModuleLevel{}, err
+	}
+	if m.disable.Load() {
+		return ModuleLevel{}, errors.Errorf("log module %q is disabled", module)
+	}
+	if ttl <= 0 {
+		ttl = DefaultModuleLevelTTL
+	}
+	m.override.Store(&moduleOverride{level: level, expiresAt: time.Now().Add(ttl)})
+	return m.info(), nil
+}
+
+// ResetModuleLevel 实现 ModuleLevels
+func (l *logger) ResetModuleLevel(module string) (ModuleLevel, error) {
+	m, err := l.modules.get(module)
+	if err != nil {
+		return ModuleLevel{}, err
+	}
+	m.override.Store(nil)
+	return m.info(), nil
+}
+
+// ModuleOverrideLevel 实现 ModuleLevels
+func (l *logger) ModuleOverrideLevel(module string) (log.Level, bool) {
+	m, err := l.modules.get(module)
+	if err != nil {
+		return 0, false
+	}
+	return m.level()
+}
+
+// WithModule 绑定模块，按模块配置过滤级别和 key，并注册到 ModuleLevels
+func (l *logger) WithModule(module string, config ModuleConfig) Logger {
+	m := l.modules.register(module, config)
+	if config == nil {
+		ll := l.With(moduleKey, module).(*logger)
+		ll.module = m
+		return ll
+	}
+	if config.GetDisable() {
+		return l.Disable()
+	}
+	ll := l.With(moduleKey, module).FilterKeys(config.GetFilterKeys()...).(*logger)
+	if config.GetLevel() != "" {
+		level := log.ParseLevel(config.GetLevel())
+		ll.level = &level
+	}
+	ll.module = m
+	return ll
+}
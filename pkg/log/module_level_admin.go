@@ -0,0 +1,96 @@
+package log
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/jaggerzhuang1994/kratos-foundation/pkg/admin"
+)
+
+// ModuleLevelPath 模块日志级别的管理接口路径（相对于管理接口前缀）
+const ModuleLevelPath = "/log/modules"
+
+// ModuleLevelAdmin 模块日志级别管理接口
+//   - GET {server.http.admin.path}/log/modules：所有模块的配置级别和临时调整的级别
+//   - PUT {server.http.admin.path}/log/modules/{module}?level=debug&ttl=10m：临时调整，ttl 默认 10m
+//   - DELETE {server.http.admin.path}/log/modules/{module}：恢复配置的级别
+//
+// 模块名称即 WithModule 的名称，例如 redis、database/gorm、client、job、server/websocket
+type ModuleLevelAdmin http.Handler
+
+type moduleLevelAdmin struct {
+	levels ModuleLevels
+	log    Log
+}
+
+// NewModuleLevelAdmin 创建模块日志级别管理接口，并注册到管理接口
+func NewModuleLevelAdmin(levels ModuleLevels, log Log, adm admin.Admin) ModuleLevelAdmin {
+	a := &moduleLevelAdmin{
+		levels: levels,
+		log:    log.WithModule("log/admin"),
+	}
+	adm.HandleFunc(ModuleLevelPath, a.list)
+	adm.HandleFunc(ModuleLevelPath+"/{module...}", a.update)
+	return a
+}
+
+func (a *moduleLevelAdmin) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == ModuleLevelPath {
+		a.list(w, r)
+		return
+	}
+	a.update(w, r)
+}
+
+func (a *moduleLevelAdmin) list(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		admin.WriteJSON(w, http.StatusMethodNotAllowed, map[string]any{"error": "method not allowed"})
+		return
+	}
+	admin.WriteJSON(w, http.StatusOK, map[string]any{"modules": a.levels.ModuleLevels()})
+}
+
+func (a *moduleLevelAdmin) update(w http.ResponseWriter, r *http.Request) {
+	module := r.PathValue("module")
+	if module == "" {
+		module = strings.TrimPrefix(r.URL.Path, ModuleLevelPath+"/")
+	}
+
+	var (
+		info ModuleLevel
+		err  error
+	)
+	switch r.Method {
+	case http.MethodPut, http.MethodPost:
+		level, ok := ParseRequestLevel(r.URL.Query().Get("level"))
+		if !ok {
+			admin.WriteJSON(w, http.StatusBadRequest, map[string]any{"error": "level must be one of debug, info, warn, error"})
+			return
+		}
+		var ttl time.Duration
+		if s := r.URL.Query().Get("ttl"); s != "" {
+			if ttl, err = time.ParseDuration(s); err != nil || ttl <= 0 {
+				admin.WriteJSON(w, http.StatusBadRequest, map[string]any{"error": "invalid ttl " + s})
+				return
+			}
+		}
+		info, err = a.levels.SetModuleLevel(module, level, ttl)
+		if err == nil {
+			a.log.Infow("msg", "module log level changed", "log_module", module, "module_level", info.Level, "expires_at", info.ExpiresAt)
+		}
+	case http.MethodDelete:
+		info, err = a.levels.ResetModuleLevel(module)
+		if err == nil {
+			a.log.Infow("msg", "module log level reset", "log_module", module)
+		}
+	default:
+		admin.WriteJSON(w, http.StatusMethodNotAllowed, map[string]any{"error": "method not allowed"})
+		return
+	}
+	if err != nil {
+		admin.WriteJSON(w, http.StatusBadRequest, map[string]any{"error": err.Error()})
+		return
+	}
+	admin.WriteJSON(w, http.StatusOK, info)
+}
@@ -0,0 +1,27 @@
+package log
+
+import (
+	"testing"
+
+	"github.com/jaggerzhuang1994/kratos-foundation/proto/kratos_foundation_pb/config_pb"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestModuleLevelsRegisterKeepsConfig(t *testing.T) {
+	var s moduleLevels
+	var unset *config_pb.ModuleLog
+	s.register("job", nil)
+	s.register("job", &config_pb.ModuleLog{Level: proto.String("warn")})
+	// 没有配置的注册不覆盖已经配置的级别
+	s.register("job", nil)
+	s.register("job", unset)
+	s.register("job", &config_pb.ModuleLog{Level: proto.String("debug")})
+
+	m, err := s.get("job")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info := m.info(); info.ConfigLevel != "warn" || info.Disable {
+		t.Errorf("module level = %+v, want config level warn", info)
+	}
+}
@@ -0,0 +1,63 @@
+package log
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/jaggerzhuang1994/kratos-foundation/internal/logger"
+	"github.com/jaggerzhuang1994/kratos-foundation/proto/kratos_foundation_pb/config_pb"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploghttp"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+)
+
+// newOtlpLogger 按配置创建 OTLP 日志器，日志携带 resource 属性（服务信息），
+// trace.id 和 span.id 作为日志记录的 trace id 和 span id 上报
+func (l *logger) newOtlpLogger(c *config_pb.OtlpLogger) (log.Logger, func(), error) {
+	exporter, err := newOtlpExporter(c.GetExporter())
+	if err != nil {
+		return nil, nil, errors.WithMessage(err, "new otlp log exporter")
+	}
+	batch := c.GetBatch()
+	otlpLogger, rc := internal_logger.NewOtlpLogger(internal_logger.OtlpLoggerConfig{
+		Exporter:           exporter,
+		Resource:           l.serviceAttributes,
+		TimeKey:            tsKey,
+		TraceIDKey:         traceIDKey,
+		SpanIDKey:          spanIDKey,
+		MaxQueueSize:       int(batch.GetMaxQueueSize()),
+		ExportInterval:     batch.GetExportInterval().AsDuration(),
+		MaxExportBatchSize: int(batch.GetMaxExportBatchSize()),
+	})
+	return otlpLogger, rc, nil
+}
+
+// newOtlpExporter 创建 OTLP HTTP 日志导出器，配置与链路追踪的导出器相同
+func newOtlpExporter(exporterConfig *config_pb.Exporter) (sdklog.Exporter, error) {
+	var opts []otlploghttp.Option
+
+	if exporterConfig.GetEndpointUrl() != "" {
+		opts = append(opts, otlploghttp.WithEndpointURL(exporterConfig.GetEndpointUrl()))
+	}
+
+	opts = append(opts, otlploghttp.WithCompression(otlploghttp.Compression(exporterConfig.GetCompression())))
+
+	if exporterConfig.GetHeaders() != nil {
+		opts = append(opts, otlploghttp.WithHeaders(exporterConfig.GetHeaders()))
+	}
+
+	if exporterConfig.GetTimeout().AsDuration() > 0 {
+		opts = append(opts, otlploghttp.WithTimeout(exporterConfig.GetTimeout().AsDuration()))
+	}
+
+	if exporterConfig.GetRetry() != nil {
+		opts = append(opts, otlploghttp.WithRetry(otlploghttp.RetryConfig{
+			Enabled:         exporterConfig.GetRetry().GetEnabled(),
+			InitialInterval: exporterConfig.GetRetry().GetInitialInterval().AsDuration(),
+			MaxInterval:     exporterConfig.GetRetry().GetMaxInterval().AsDuration(),
+			MaxElapsedTime:  exporterConfig.GetRetry().GetMaxElapsedTime().AsDuration(),
+		}))
+	}
+
+	return otlploghttp.New(context.Background(), opts...)
+}
@@ -0,0 +1,153 @@
+package log
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware/tracing"
+	"github.com/jaggerzhuang1994/kratos-foundation/pkg/app_info"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	logspb "go.opentelemetry.io/proto/otlp/logs/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// otlpServer 模拟 OTLP HTTP 日志接收端
+type otlpServer struct {
+	mu       sync.Mutex
+	requests []*collogspb.ExportLogsServiceRequest
+	headers  []http.Header
+}
+
+func (s *otlpServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	req := new(collogspb.ExportLogsServiceRequest)
+	if err = proto.Unmarshal(body, req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	s.mu.Lock()
+	s.requests = append(s.requests, req)
+	s.headers = append(s.headers, r.Header.Clone())
+	s.mu.Unlock()
+
+	resp, _ := proto.Marshal(new(collogspb.ExportLogsServiceResponse))
+	w.Header().Set("Content-Type", "application/x-protobuf")
+	_, _ = w.Write(resp)
+}
+
+// records 收到的所有日志记录和对应的 resource 属性
+func (s *otlpServer) records() ([]*logspb.LogRecord, map[string]string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var records []*logspb.LogRecord
+	resource := map[string]string{}
+	for _, req := range s.requests {
+		for _, rl := range req.GetResourceLogs() {
+			for _, attr := range rl.GetResource().GetAttributes() {
+				resource[attr.GetKey()] = attr.GetValue().GetStringValue()
+			}
+			for _, sl := range rl.GetScopeLogs() {
+				records = append(records, sl.GetLogRecords()...)
+			}
+		}
+	}
+	return records, resource
+}
+
+func TestOtlpLogger(t *testing.T) {
+	otlp := &otlpServer{}
+	srv := httptest.NewServer(otlp)
+	defer srv.Close()
+
+	config := NewDefaultConfig()
+	config.Level = proto.String("info")
+	config.File.Disable = proto.Bool(true)
+	config.Std.Disable = proto.Bool(true)
+	config.Otlp.Disable = proto.Bool(false)
+	config.Otlp.Level = proto.String("info")
+	config.Otlp.Exporter.EndpointUrl = proto.String(srv.URL + "/v1/logs")
+	config.Otlp.Exporter.Headers = map[string]string{"x-api-key": "demo"}
+
+	presetKv := PresetKv{
+		serviceNameKey: "demo",
+		traceIDKey:     tracing.TraceID(),
+		spanIDKey:      tracing.SpanID(),
+	}
+	serviceAttributes := app_info.ServiceAttributes{
+		attribute.String("service.name", "demo"),
+		attribute.String("service.version", "v1.0.0"),
+	}
+	logger, release, err := NewLogger(presetKv, config, NewHook(), serviceAttributes)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	traceID, _ := trace.TraceIDFromHex("0102030405060708090a0b0c0d0e0f10")
+	spanID, _ := trace.SpanIDFromHex("0102030405060708")
+	ctx := trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     spanID,
+		TraceFlags: trace.FlagsSampled,
+	}))
+
+	_ = logger.WithContext(ctx).Log(log.LevelInfo, log.DefaultMessageKey, "hello", "user", "alice", "count", 3)
+	_ = logger.Log(log.LevelDebug, log.DefaultMessageKey, "ignored")
+	_ = logger.Log(log.LevelError, log.DefaultMessageKey, "without trace")
+	// 释放时导出队列中剩余的日志
+	release()
+
+	records, resource := otlp.records()
+	if resource["service.name"] != "demo" || resource["service.version"] != "v1.0.0" {
+		t.Errorf("resource = %v", resource)
+	}
+	if len(otlp.headers) == 0 || otlp.headers[0].Get("x-api-key") != "demo" {
+		t.Errorf("headers = %v", otlp.headers)
+	}
+	if len(records) != 2 {
+		t.Fatalf("records = %v", records)
+	}
+
+	record := records[0]
+	if record.GetBody().GetStringValue() != "hello" {
+		t.Errorf("body = %v", record.GetBody())
+	}
+	if record.GetSeverityNumber() != logspb.SeverityNumber_SEVERITY_NUMBER_INFO || record.GetSeverityText() != "INFO" {
+		t.Errorf("severity = %v %v", record.GetSeverityNumber(), record.GetSeverityText())
+	}
+	if trace.TraceID(record.GetTraceId()) != traceID || trace.SpanID(record.GetSpanId()) != spanID {
+		t.Errorf("trace id = %x, span id = %x", record.GetTraceId(), record.GetSpanId())
+	}
+	attrs := map[string]any{}
+	for _, attr := range record.GetAttributes() {
+		attrs[attr.GetKey()] = attr.GetValue()
+	}
+	if attrs["user"].(interface{ GetStringValue() string }).GetStringValue() != "alice" ||
+		attrs["count"].(interface{ GetIntValue() int64 }).GetIntValue() != 3 {
+		t.Errorf("attributes = %v", attrs)
+	}
+	// trace.id 和 span.id 作为日志记录的字段上报，服务信息已在 resource 中，都不作为属性重复上报
+	for _, key := range []string{traceIDKey, spanIDKey, serviceNameKey} {
+		if _, ok := attrs[key]; ok {
+			t.Errorf("attribute %s should not be reported, attributes = %v", key, attrs)
+		}
+	}
+
+	record = records[1]
+	if record.GetBody().GetStringValue() != "without trace" || record.GetSeverityNumber() != logspb.SeverityNumber_SEVERITY_NUMBER_ERROR {
+		t.Errorf("record = %v", record)
+	}
+	if len(record.GetTraceId()) != 0 || len(record.GetSpanId()) != 0 {
+		t.Errorf("trace id = %x, span id = %x", record.GetTraceId(), record.GetSpanId())
+	}
+}
@@ -2,6 +2,7 @@ package log
 
 import (
 	"context"
+	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware/tracing"
@@ -51,7 +52,9 @@ var defaultCaller = log.Caller(defaultCallerDepth)
 // 返回：包含所有预设字段的键值对映射
 func NewPresetKv(appInfo app_info.AppInfo) PresetKv {
 	return PresetKv{
-		tsKey: log.DefaultTimestamp, // 默认格式的时间戳
+		tsKey: log.Valuer(func(context.Context) any { // 时间戳，由输出目标按时间格式编码
+			return time.Now()
+		}),
 		serviceIDKey: log.Valuer(func(context.Context) interface{} {
 			return appInfo.GetId()
 		}),
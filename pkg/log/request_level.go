@@ -0,0 +1,79 @@
+package log
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// 按请求调整日志级别的 metadata key，以 x-md- 为前缀，会随 metadata 中间件传递给下游
+const (
+	// RequestLevelKey 请求日志级别，支持 debug、info、warn、error
+	RequestLevelKey = "x-md-log-level"
+	// RequestLevelTokenKey 白名单 token
+	RequestLevelTokenKey = "x-md-log-level-token"
+	// RequestLevelSignKey 签名，由 SignRequestLevel 生成
+	RequestLevelSignKey = "x-md-log-level-sign"
+)
+
+type levelKey struct{}
+
+// NewLevelContext 设置当前请求的日志级别
+// 通过 WithContext(ctx) 输出的日志，不低于该级别时不受全局级别和模块级别的限制（模块禁用除外）
+func NewLevelContext(ctx context.Context, level log.Level) context.Context {
+	return context.WithValue(ctx, levelKey{}, level)
+}
+
+// LevelFromContext 获取当前请求的日志级别
+func LevelFromContext(ctx context.Context) (level log.Level, ok bool) {
+	if ctx == nil {
+		return
+	}
+	level, ok = ctx.Value(levelKey{}).(log.Level)
+	return
+}
+
+// ParseRequestLevel 解析请求日志级别，只接受 debug、info、warn、error
+func ParseRequestLevel(s string) (log.Level, bool) {
+	switch strings.ToLower(s) {
+	case "debug", "info", "warn", "error":
+		return log.ParseLevel(s), true
+	default:
+		return 0, false
+	}
+}
+
+// SignRequestLevel 生成请求日志级别的签名，格式为 {过期时间戳}.{hex(hmac-sha256(secret, level.过期时间戳))}
+func SignRequestLevel(secret, level string, expires time.Time) string {
+	ts := strconv.FormatInt(expires.Unix(), 10)
+	return ts + "." + hex.EncodeToString(requestLevelMac(secret, level, ts))
+}
+
+// VerifyRequestLevel 校验请求日志级别的签名，签名过期或不匹配时返回 false
+func VerifyRequestLevel(secret, level, sign string) bool {
+	ts, mac, ok := strings.Cut(sign, ".")
+	if !ok || secret == "" {
+		return false
+	}
+	expires, err := strconv.ParseInt(ts, 10, 64)
+	if err != nil || time.Now().Unix() > expires {
+		return false
+	}
+	got, err := hex.DecodeString(mac)
+	if err != nil {
+		return false
+	}
+	return hmac.Equal(got, requestLevelMac(secret, level, ts))
+}
+
+func requestLevelMac(secret, level, ts string) []byte {
+	h := hmac.New(sha256.New, []byte(secret))
+	h.Write([]byte(strings.ToLower(level) + "." + ts))
+	return h.Sum(nil)
+}
@@ -11,7 +11,10 @@ var ProviderSet = wire.NewSet(
 	NewLogger,
 	NewHook,
 	NewLog,
+	NewModuleLevelAdmin,
 
 	wire.Bind(new(UpdateLogger), new(Logger)),
+	wire.Bind(new(AsyncStatsProvider), new(Logger)),
+	wire.Bind(new(ModuleLevels), new(Logger)),
 	wire.Bind(new(log.Logger), new(Logger)),
 )
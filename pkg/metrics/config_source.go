@@ -0,0 +1,66 @@
+package metrics
+
+import (
+	"context"
+
+	config2 "github.com/jaggerzhuang1994/kratos-foundation/pkg/config"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// registerConfigSourceMetrics 注册配置源指标
+//
+// 配置源在 metrics 之前初始化（启动时就可能使用本地快照），
+// 因此使用异步指标，在采集时读取配置源的状态：
+//   - config_source_fallback: 配置源是否正在使用本地快照（1 是，0 否）
+//   - config_source_fallback_total: 配置源累计使用本地快照的次数
+func registerConfigSourceMetrics(meter metric.Meter, layers config2.ConfigSourceLayers) error {
+	fallback, err := meter.Int64ObservableGauge(
+		"config_source_fallback",
+		metric.WithDescription("config source is using local snapshot"),
+	)
+	if err != nil {
+		return err
+	}
+	fallbackTotal, err := meter.Int64ObservableCounter(
+		"config_source_fallback_total",
+		metric.WithDescription("total number of config source fallbacks to local snapshot"),
+	)
+	if err != nil {
+		return err
+	}
+	_, err = meter.RegisterCallback(func(_ context.Context, o metric.Observer) error {
+		for _, layer := range layers.Layers() {
+			attrs := metric.WithAttributes(attribute.String("source", layer.Name))
+			var v int64
+			if layer.Fallback {
+				v = 1
+			}
+			o.ObserveInt64(fallback, v, attrs)
+			o.ObserveInt64(fallbackTotal, layer.FallbackTotal, attrs)
+		}
+		return nil
+	}, fallback, fallbackTotal)
+	return err
+}
+
+// registerConfigReloadMetrics 注册配置热更新指标
+//
+// 同样使用异步指标，采集时读取 ConfigAudit 的统计：
+//   - config_reload_total: 配置热更新次数，result 为 success、rejected（schema 校验失败）、failed（解析失败）
+func registerConfigReloadMetrics(meter metric.Meter, audit config2.ConfigAudit) error {
+	reloadTotal, err := meter.Int64ObservableCounter(
+		"config_reload_total",
+		metric.WithDescription("total number of config reloads by result"),
+	)
+	if err != nil {
+		return err
+	}
+	_, err = meter.RegisterCallback(func(_ context.Context, o metric.Observer) error {
+		for result, total := range audit.ReloadTotal() {
+			o.ObserveInt64(reloadTotal, total, metric.WithAttributes(attribute.String("result", result)))
+		}
+		return nil
+	}, reloadTotal)
+	return err
+}
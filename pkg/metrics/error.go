@@ -6,4 +6,17 @@ var (
 	counterAlreadyExistsErr   = errors.New("counter already exists")
 	gaugeAlreadyExistsErr     = errors.New("gauge already exists")
 	histogramAlreadyExistsErr = errors.New("histogram already exists")
+
+	float64CounterAlreadyExistsErr       = errors.New("float64 counter already exists")
+	upDownCounterAlreadyExistsErr        = errors.New("up down counter already exists")
+	float64UpDownCounterAlreadyExistsErr = errors.New("float64 up down counter already exists")
+	float64GaugeAlreadyExistsErr         = errors.New("float64 gauge already exists")
+	int64HistogramAlreadyExistsErr       = errors.New("int64 histogram already exists")
+
+	observableGaugeAlreadyExistsErr                = errors.New("observable gauge already exists")
+	float64ObservableGaugeAlreadyExistsErr         = errors.New("float64 observable gauge already exists")
+	observableCounterAlreadyExistsErr              = errors.New("observable counter already exists")
+	float64ObservableCounterAlreadyExistsErr       = errors.New("float64 observable counter already exists")
+	observableUpDownCounterAlreadyExistsErr        = errors.New("observable up down counter already exists")
+	float64ObservableUpDownCounterAlreadyExistsErr = errors.New("float64 observable up down counter already exists")
 )
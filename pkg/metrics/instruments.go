@@ -0,0 +1,76 @@
+package metrics
+
+import (
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// instruments 按名称注册的指标
+type instruments[T any] struct {
+	mux       sync.RWMutex
+	m         map[string]T
+	existsErr error
+}
+
+func newInstruments[T any](size int32, existsErr error) *instruments[T] {
+	return &instruments[T]{
+		m:         make(map[string]T, size),
+		existsErr: existsErr,
+	}
+}
+
+// register 注册已创建的指标，名称已存在时返回 existsErr
+func (i *instruments[T]) register(name string, instrument T) error {
+	i.mux.Lock()
+	defer i.mux.Unlock()
+
+	// 如果已经存在，则不写入
+	if _, ok := i.m[name]; ok {
+		return errors.WithMessage(i.existsErr, "name: "+name)
+	}
+
+	i.m[name] = instrument
+	return nil
+}
+
+// registerNew 创建并注册指标，名称已存在时返回 existsErr
+func (i *instruments[T]) registerNew(name string, create func() (T, error)) (T, error) {
+	i.mux.Lock()
+	defer i.mux.Unlock()
+
+	var zero T
+	// 如果已经存在，则不写入
+	if _, ok := i.m[name]; ok {
+		return zero, errors.WithMessage(i.existsErr, "name: "+name)
+	}
+
+	instrument, err := create()
+	if err != nil {
+		return zero, err
+	}
+
+	i.m[name] = instrument
+	return instrument, nil
+}
+
+func (i *instruments[T]) get(name string) (T, bool) {
+	i.mux.RLock()
+	defer i.mux.RUnlock()
+	instrument, ok := i.m[name]
+	return instrument, ok
+}
+
+// getOrNew 获取指标，不存在则创建一个新的
+func (i *instruments[T]) getOrNew(name string, create func() (T, error)) (T, error) {
+	if instrument, ok := i.get(name); ok {
+		return instrument, nil
+	}
+	instrument, err := i.registerNew(name, create)
+	// 如果是已存在（并发创建），则再读一次
+	if errors.Is(err, i.existsErr) {
+		instrument, _ = i.get(name)
+		return instrument, nil
+	}
+	return instrument, err
+}
@@ -0,0 +1,50 @@
+package metrics
+
+import (
+	"context"
+
+	"github.com/jaggerzhuang1994/kratos-foundation/pkg/log"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// registerLogAsyncMetrics 注册日志异步写入指标
+//
+// 日志在 metrics 之前初始化，并且配置热更新时会重新创建输出目标，
+// 因此使用异步指标，在采集时读取日志的状态，只包含开启过异步写入的输出目标：
+//   - log_async_queue_depth: 缓冲区中等待写入的日志条数
+//   - log_async_queue_capacity: 缓冲区大小
+//   - log_async_dropped_total: 缓冲区满时累计丢弃的日志条数
+func registerLogAsyncMetrics(meter metric.Meter, provider log.AsyncStatsProvider) error {
+	depth, err := meter.Int64ObservableGauge(
+		"log_async_queue_depth",
+		metric.WithDescription("number of log entries waiting in the async buffer"),
+	)
+	if err != nil {
+		return err
+	}
+	capacity, err := meter.Int64ObservableGauge(
+		"log_async_queue_capacity",
+		metric.WithDescription("capacity of the async log buffer"),
+	)
+	if err != nil {
+		return err
+	}
+	dropped, err := meter.Int64ObservableCounter(
+		"log_async_dropped_total",
+		metric.WithDescription("total number of log entries dropped because the async buffer is full"),
+	)
+	if err != nil {
+		return err
+	}
+	_, err = meter.RegisterCallback(func(_ context.Context, o metric.Observer) error {
+		for _, stats := range provider.AsyncStats() {
+			attrs := metric.WithAttributes(attribute.String("sink", stats.Sink))
+			o.ObserveInt64(depth, int64(stats.Depth), attrs)
+			o.ObserveInt64(capacity, int64(stats.Capacity), attrs)
+			o.ObserveInt64(dropped, stats.Dropped, attrs)
+		}
+		return nil
+	}, depth, capacity, dropped)
+	return err
+}
@@ -2,9 +2,9 @@ package metrics
 
 import (
 	"context"
-	"sync"
 
 	"github.com/jaggerzhuang1994/kratos-foundation/pkg/app_info"
+	config2 "github.com/jaggerzhuang1994/kratos-foundation/pkg/config"
 	"github.com/jaggerzhuang1994/kratos-foundation/pkg/log"
 	"github.com/pkg/errors"
 	"go.opentelemetry.io/otel/exporters/prometheus"
@@ -26,13 +26,42 @@ type Metrics interface {
 	RegisterNewCounter(name string, options ...metric.Int64CounterOption) (metric.Int64Counter, error)
 	AddCounter(ctx context.Context, name string, incr int64, options ...metric.AddOption) bool
 
+	RegisterFloat64Counter(name string, counter metric.Float64Counter) error
+	RegisterNewFloat64Counter(name string, options ...metric.Float64CounterOption) (metric.Float64Counter, error)
+	AddFloat64Counter(ctx context.Context, name string, incr float64, options ...metric.AddOption) bool
+
+	RegisterUpDownCounter(name string, counter metric.Int64UpDownCounter) error
+	RegisterNewUpDownCounter(name string, options ...metric.Int64UpDownCounterOption) (metric.Int64UpDownCounter, error)
+	AddUpDownCounter(ctx context.Context, name string, incr int64, options ...metric.AddOption) bool
+
+	RegisterFloat64UpDownCounter(name string, counter metric.Float64UpDownCounter) error
+	RegisterNewFloat64UpDownCounter(name string, options ...metric.Float64UpDownCounterOption) (metric.Float64UpDownCounter, error)
+	AddFloat64UpDownCounter(ctx context.Context, name string, incr float64, options ...metric.AddOption) bool
+
 	RegisterGauge(name string, gauge metric.Int64Gauge) error
 	RegisterNewGauge(name string, options ...metric.Int64GaugeOption) (metric.Int64Gauge, error)
 	RecordGauge(ctx context.Context, name string, value int64, options ...metric.RecordOption) bool
 
+	RegisterFloat64Gauge(name string, gauge metric.Float64Gauge) error
+	RegisterNewFloat64Gauge(name string, options ...metric.Float64GaugeOption) (metric.Float64Gauge, error)
+	RecordFloat64Gauge(ctx context.Context, name string, value float64, options ...metric.RecordOption) bool
+
 	RegisterHistogram(name string, histogram metric.Float64Histogram) error
 	RegisterNewHistogram(name string, options ...metric.Float64HistogramOption) (metric.Float64Histogram, error)
 	RecordHistogram(ctx context.Context, name string, incr float64, options ...metric.RecordOption) bool
+
+	RegisterInt64Histogram(name string, histogram metric.Int64Histogram) error
+	RegisterNewInt64Histogram(name string, options ...metric.Int64HistogramOption) (metric.Int64Histogram, error)
+	RecordInt64Histogram(ctx context.Context, name string, incr int64, options ...metric.RecordOption) bool
+
+	// 异步指标，采集时执行回调，返回的 Registration.Unregister 取消回调
+	// 同名指标只在第一次注册时创建（options 生效），之后注册的回调共用该指标，例如按属性区分的多个队列
+	RegisterObservableGauge(name string, callback metric.Int64Callback, options ...metric.Int64ObservableGaugeOption) (metric.Registration, error)
+	RegisterFloat64ObservableGauge(name string, callback metric.Float64Callback, options ...metric.Float64ObservableGaugeOption) (metric.Registration, error)
+	RegisterObservableCounter(name string, callback metric.Int64Callback, options ...metric.Int64ObservableCounterOption) (metric.Registration, error)
+	RegisterFloat64ObservableCounter(name string, callback metric.Float64Callback, options ...metric.Float64ObservableCounterOption) (metric.Registration, error)
+	RegisterObservableUpDownCounter(name string, callback metric.Int64Callback, options ...metric.Int64ObservableUpDownCounterOption) (metric.Registration, error)
+	RegisterFloat64ObservableUpDownCounter(name string, callback metric.Float64Callback, options ...metric.Float64ObservableUpDownCounterOption) (metric.Registration, error)
 }
 
 type metrics struct {
@@ -43,22 +72,37 @@ type metrics struct {
 	meter metric.Meter
 
 	// 累积量
-	counterMux sync.RWMutex
-	counterMap map[string]metric.Int64Counter
+	counters        *instruments[metric.Int64Counter]
+	float64Counters *instruments[metric.Float64Counter]
+
+	// 可增可减的累积量
+	upDownCounters        *instruments[metric.Int64UpDownCounter]
+	float64UpDownCounters *instruments[metric.Float64UpDownCounter]
 
 	// 瞬时量
-	gaugeMux sync.RWMutex
-	gaugeMap map[string]metric.Int64Gauge
+	gauges        *instruments[metric.Int64Gauge]
+	float64Gauges *instruments[metric.Float64Gauge]
 
 	// 耗时
-	histogramMux sync.RWMutex
-	histogramMap map[string]metric.Float64Histogram
+	histograms      *instruments[metric.Float64Histogram]
+	int64Histograms *instruments[metric.Int64Histogram]
+
+	// 异步指标
+	observableGauges                *instruments[metric.Int64ObservableGauge]
+	float64ObservableGauges         *instruments[metric.Float64ObservableGauge]
+	observableCounters              *instruments[metric.Int64ObservableCounter]
+	float64ObservableCounters       *instruments[metric.Float64ObservableCounter]
+	observableUpDownCounters        *instruments[metric.Int64ObservableUpDownCounter]
+	float64ObservableUpDownCounters *instruments[metric.Float64ObservableUpDownCounter]
 }
 
 func NewMetrics(
 	log log.Log,
 	config Config,
 	serviceAttrs app_info.ServiceAttributes,
+	configSourceLayers config2.ConfigSourceLayers,
+	configAudit config2.ConfigAudit,
+	logAsyncStats log.AsyncStatsProvider,
 ) (Metrics, error) {
 	exporter, err := prometheus.New()
 	if err != nil {
@@ -73,16 +117,49 @@ func NewMetrics(
 
 	meter := mp.Meter(config.GetMeterName(), metric.WithInstrumentationAttributes(serviceAttrs...))
 
+	err = registerConfigSourceMetrics(meter, configSourceLayers)
+	if err != nil {
+		return nil, errors.WithMessage(err, "register config source metrics failed")
+	}
+	err = registerConfigReloadMetrics(meter, configAudit)
+	if err != nil {
+		return nil, errors.WithMessage(err, "register config reload metrics failed")
+	}
+	err = registerLogAsyncMetrics(meter, logAsyncStats)
+	if err != nil {
+		return nil, errors.WithMessage(err, "register log async metrics failed")
+	}
+
+	return newMetrics(log.WithModule("metrics", config.GetLog()), config, mp, meter), nil
+}
+
+func newMetrics(log log.Log, config Config, mp metric.MeterProvider, meter metric.Meter) *metrics {
+	counterMapSize := config.GetCounterMapSize()
+	gaugeMapSize := config.GetGaugeMapSize()
+	histogramMapSize := config.GetHistogramMapSize()
 	return &metrics{
-		log:    log.WithModule("metrics", config.GetLog()),
+		log:    log,
 		config: config,
 
-		mp:           mp,
-		meter:        meter,
-		counterMap:   make(map[string]metric.Int64Counter, config.GetCounterMapSize()),
-		gaugeMap:     make(map[string]metric.Int64Gauge, config.GetGaugeMapSize()),
-		histogramMap: make(map[string]metric.Float64Histogram, config.GetHistogramMapSize()),
-	}, nil
+		mp:    mp,
+		meter: meter,
+
+		counters:              newInstruments[metric.Int64Counter](counterMapSize, counterAlreadyExistsErr),
+		float64Counters:       newInstruments[metric.Float64Counter](counterMapSize, float64CounterAlreadyExistsErr),
+		upDownCounters:        newInstruments[metric.Int64UpDownCounter](counterMapSize, upDownCounterAlreadyExistsErr),
+		float64UpDownCounters: newInstruments[metric.Float64UpDownCounter](counterMapSize, float64UpDownCounterAlreadyExistsErr),
+		gauges:                newInstruments[metric.Int64Gauge](gaugeMapSize, gaugeAlreadyExistsErr),
+		float64Gauges:         newInstruments[metric.Float64Gauge](gaugeMapSize, float64GaugeAlreadyExistsErr),
+		histograms:            newInstruments[metric.Float64Histogram](histogramMapSize, histogramAlreadyExistsErr),
+		int64Histograms:       newInstruments[metric.Int64Histogram](histogramMapSize, int64HistogramAlreadyExistsErr),
+
+		observableGauges:                newInstruments[metric.Int64ObservableGauge](gaugeMapSize, observableGaugeAlreadyExistsErr),
+		float64ObservableGauges:         newInstruments[metric.Float64ObservableGauge](gaugeMapSize, float64ObservableGaugeAlreadyExistsErr),
+		observableCounters:              newInstruments[metric.Int64ObservableCounter](counterMapSize, observableCounterAlreadyExistsErr),
+		float64ObservableCounters:       newInstruments[metric.Float64ObservableCounter](counterMapSize, float64ObservableCounterAlreadyExistsErr),
+		observableUpDownCounters:        newInstruments[metric.Int64ObservableUpDownCounter](counterMapSize, observableUpDownCounterAlreadyExistsErr),
+		float64ObservableUpDownCounters: newInstruments[metric.Float64ObservableUpDownCounter](counterMapSize, float64ObservableUpDownCounterAlreadyExistsErr),
+	}
 }
 
 func (m *metrics) GetMeterProvider() metric.MeterProvider {
@@ -98,180 +175,185 @@ func (m *metrics) GetMeterName() string {
 }
 
 func (m *metrics) RegisterCounter(name string, counter metric.Int64Counter) error {
-	m.counterMux.Lock()
-	defer m.counterMux.Unlock()
-
-	// 如果已经存在，则不写入
-	if _, ok := m.counterMap[name]; ok {
-		return errors.WithMessage(counterAlreadyExistsErr, "name: "+name)
-	}
-
-	m.counterMap[name] = counter
-	return nil
+	return m.counters.register(name, counter)
 }
 
 func (m *metrics) RegisterNewCounter(name string, options ...metric.Int64CounterOption) (metric.Int64Counter, error) {
-	m.counterMux.Lock()
-	defer m.counterMux.Unlock()
-
-	counter, err := m.meter.Int64Counter(name, options...)
-	if err != nil {
-		return nil, err
-	}
-
-	// 如果已经存在，则不写入
-	if _, ok := m.counterMap[name]; ok {
-		return nil, errors.WithMessage(counterAlreadyExistsErr, "name: "+name)
-	}
-
-	m.counterMap[name] = counter
-	return counter, nil
+	return m.counters.registerNew(name, func() (metric.Int64Counter, error) {
+		return m.meter.Int64Counter(name, options...)
+	})
 }
 
 func (m *metrics) AddCounter(ctx context.Context, name string, incr int64, options ...metric.AddOption) bool {
-	getCounter := func() (metric.Int64Counter, bool) {
-		m.counterMux.RLock()
-		defer m.counterMux.RUnlock()
-		counter, ok := m.counterMap[name]
-		return counter, ok
-	}
-	counter, ok := getCounter()
-
 	// counter 不存在，则初始化一个新的
-	var err error
-	if !ok {
-		_, err = m.RegisterNewCounter(name)
-		// 如果是已存在，则在读一次
-		if !errors.Is(err, counterAlreadyExistsErr) && err != nil {
-			m.log.WithContext(ctx).Warnf("AddCounter(%s, %d) failed: create new counter failed: %v", name, incr, err)
-			return false
-		}
-		counter, _ = getCounter()
+	counter, err := m.counters.getOrNew(name, func() (metric.Int64Counter, error) {
+		return m.meter.Int64Counter(name)
+	})
+	if err != nil {
+		m.log.WithContext(ctx).Warnf("AddCounter(%s, %d) failed: create new counter failed: %v", name, incr, err)
+		return false
 	}
 	counter.Add(ctx, incr, options...)
 	return true
 }
 
-func (m *metrics) RegisterGauge(name string, gauge metric.Int64Gauge) error {
-	m.gaugeMux.Lock()
-	defer m.gaugeMux.Unlock()
+func (m *metrics) RegisterFloat64Counter(name string, float64Counter metric.Float64Counter) error {
+	return m.float64Counters.register(name, float64Counter)
+}
 
-	// 如果已经存在，则不写入
-	if _, ok := m.gaugeMap[name]; ok {
-		return errors.WithMessage(gaugeAlreadyExistsErr, "name: "+name)
-	}
+func (m *metrics) RegisterNewFloat64Counter(name string, options ...metric.Float64CounterOption) (metric.Float64Counter, error) {
+	return m.float64Counters.registerNew(name, func() (metric.Float64Counter, error) {
+		return m.meter.Float64Counter(name, options...)
+	})
+}
 
-	// 懒加载 map（如果你别处已经初始化，就可以删掉这段）
-	if m.gaugeMap == nil {
-		m.gaugeMap = make(map[string]metric.Int64Gauge)
+func (m *metrics) AddFloat64Counter(ctx context.Context, name string, incr float64, options ...metric.AddOption) bool {
+	// float64 counter 不存在，则初始化一个新的
+	float64Counter, err := m.float64Counters.getOrNew(name, func() (metric.Float64Counter, error) {
+		return m.meter.Float64Counter(name)
+	})
+	if err != nil {
+		m.log.WithContext(ctx).Warnf("AddFloat64Counter(%s, %f) failed: create new float64 counter failed: %v", name, incr, err)
+		return false
 	}
+	float64Counter.Add(ctx, incr, options...)
+	return true
+}
 
-	m.gaugeMap[name] = gauge
-	return nil
+func (m *metrics) RegisterUpDownCounter(name string, upDownCounter metric.Int64UpDownCounter) error {
+	return m.upDownCounters.register(name, upDownCounter)
 }
 
-func (m *metrics) RegisterNewGauge(name string, options ...metric.Int64GaugeOption) (metric.Int64Gauge, error) {
-	m.gaugeMux.Lock()
-	defer m.gaugeMux.Unlock()
+func (m *metrics) RegisterNewUpDownCounter(name string, options ...metric.Int64UpDownCounterOption) (metric.Int64UpDownCounter, error) {
+	return m.upDownCounters.registerNew(name, func() (metric.Int64UpDownCounter, error) {
+		return m.meter.Int64UpDownCounter(name, options...)
+	})
+}
 
-	// 懒加载 map
-	if m.gaugeMap == nil {
-		m.gaugeMap = make(map[string]metric.Int64Gauge)
+func (m *metrics) AddUpDownCounter(ctx context.Context, name string, incr int64, options ...metric.AddOption) bool {
+	// up down counter 不存在，则初始化一个新的
+	upDownCounter, err := m.upDownCounters.getOrNew(name, func() (metric.Int64UpDownCounter, error) {
+		return m.meter.Int64UpDownCounter(name)
+	})
+	if err != nil {
+		m.log.WithContext(ctx).Warnf("AddUpDownCounter(%s, %d) failed: create new up down counter failed: %v", name, incr, err)
+		return false
 	}
+	upDownCounter.Add(ctx, incr, options...)
+	return true
+}
 
-	// 如果已经存在，则不写入
-	if _, ok := m.gaugeMap[name]; ok {
-		return nil, errors.WithMessage(gaugeAlreadyExistsErr, "name: "+name)
-	}
+func (m *metrics) RegisterFloat64UpDownCounter(name string, float64UpDownCounter metric.Float64UpDownCounter) error {
+	return m.float64UpDownCounters.register(name, float64UpDownCounter)
+}
 
-	gauge, err := m.meter.Int64Gauge(name, options...)
+func (m *metrics) RegisterNewFloat64UpDownCounter(name string, options ...metric.Float64UpDownCounterOption) (metric.Float64UpDownCounter, error) {
+	return m.float64UpDownCounters.registerNew(name, func() (metric.Float64UpDownCounter, error) {
+		return m.meter.Float64UpDownCounter(name, options...)
+	})
+}
+
+func (m *metrics) AddFloat64UpDownCounter(ctx context.Context, name string, incr float64, options ...metric.AddOption) bool {
+	// float64 up down counter 不存在，则初始化一个新的
+	float64UpDownCounter, err := m.float64UpDownCounters.getOrNew(name, func() (metric.Float64UpDownCounter, error) {
+		return m.meter.Float64UpDownCounter(name)
+	})
 	if err != nil {
-		return nil, err
+		m.log.WithContext(ctx).Warnf("AddFloat64UpDownCounter(%s, %f) failed: create new float64 up down counter failed: %v", name, incr, err)
+		return false
 	}
-
-	m.gaugeMap[name] = gauge
-	return gauge, nil
+	float64UpDownCounter.Add(ctx, incr, options...)
+	return true
 }
 
-func (m *metrics) RecordGauge(ctx context.Context, name string, value int64, options ...metric.RecordOption) bool {
-	getGauge := func() (metric.Int64Gauge, bool) {
-		m.gaugeMux.RLock()
-		defer m.gaugeMux.RUnlock()
-		gauge, ok := m.gaugeMap[name]
-		return gauge, ok
-	}
+func (m *metrics) RegisterGauge(name string, gauge metric.Int64Gauge) error {
+	return m.gauges.register(name, gauge)
+}
 
-	gauge, ok := getGauge()
+func (m *metrics) RegisterNewGauge(name string, options ...metric.Int64GaugeOption) (metric.Int64Gauge, error) {
+	return m.gauges.registerNew(name, func() (metric.Int64Gauge, error) {
+		return m.meter.Int64Gauge(name, options...)
+	})
+}
 
+func (m *metrics) RecordGauge(ctx context.Context, name string, value int64, options ...metric.RecordOption) bool {
 	// gauge 不存在，则初始化一个新的
-	var err error
-	if !ok {
-		_, err = m.RegisterNewGauge(name)
-		// 如果是已存在，则再读一次
-		if !errors.Is(err, gaugeAlreadyExistsErr) && err != nil {
-			m.log.WithContext(ctx).Warnf("RecordGauge(%s, %d) failed: create new gauge failed: %v", name, value, err)
-			return false
-		}
-		gauge, _ = getGauge()
+	gauge, err := m.gauges.getOrNew(name, func() (metric.Int64Gauge, error) {
+		return m.meter.Int64Gauge(name)
+	})
+	if err != nil {
+		m.log.WithContext(ctx).Warnf("RecordGauge(%s, %d) failed: create new gauge failed: %v", name, value, err)
+		return false
 	}
-
 	gauge.Record(ctx, value, options...)
 	return true
 }
 
-func (m *metrics) RegisterHistogram(name string, histogram metric.Float64Histogram) error {
-	m.histogramMux.Lock()
-	defer m.histogramMux.Unlock()
+func (m *metrics) RegisterFloat64Gauge(name string, float64Gauge metric.Float64Gauge) error {
+	return m.float64Gauges.register(name, float64Gauge)
+}
 
-	// 如果已经存在，则不写入
-	if _, ok := m.histogramMap[name]; ok {
-		return errors.WithMessage(histogramAlreadyExistsErr, "name: "+name)
+func (m *metrics) RegisterNewFloat64Gauge(name string, options ...metric.Float64GaugeOption) (metric.Float64Gauge, error) {
+	return m.float64Gauges.registerNew(name, func() (metric.Float64Gauge, error) {
+		return m.meter.Float64Gauge(name, options...)
+	})
+}
+
+func (m *metrics) RecordFloat64Gauge(ctx context.Context, name string, value float64, options ...metric.RecordOption) bool {
+	// float64 gauge 不存在，则初始化一个新的
+	float64Gauge, err := m.float64Gauges.getOrNew(name, func() (metric.Float64Gauge, error) {
+		return m.meter.Float64Gauge(name)
+	})
+	if err != nil {
+		m.log.WithContext(ctx).Warnf("RecordFloat64Gauge(%s, %f) failed: create new float64 gauge failed: %v", name, value, err)
+		return false
 	}
+	float64Gauge.Record(ctx, value, options...)
+	return true
+}
 
-	m.histogramMap[name] = histogram
-	return nil
+func (m *metrics) RegisterHistogram(name string, histogram metric.Float64Histogram) error {
+	return m.histograms.register(name, histogram)
 }
 
 func (m *metrics) RegisterNewHistogram(name string, options ...metric.Float64HistogramOption) (metric.Float64Histogram, error) {
-	m.histogramMux.Lock()
-	defer m.histogramMux.Unlock()
-
-	// 如果已经存在，则不写入
-	if _, ok := m.histogramMap[name]; ok {
-		return nil, errors.WithMessage(histogramAlreadyExistsErr, "name: "+name)
-	}
+	return m.histograms.registerNew(name, func() (metric.Float64Histogram, error) {
+		return m.meter.Float64Histogram(name, options...)
+	})
+}
 
-	histogram, err := m.meter.Float64Histogram(name, options...)
+func (m *metrics) RecordHistogram(ctx context.Context, name string, incr float64, options ...metric.RecordOption) bool {
+	// histogram 不存在，则初始化一个新的
+	histogram, err := m.histograms.getOrNew(name, func() (metric.Float64Histogram, error) {
+		return m.meter.Float64Histogram(name)
+	})
 	if err != nil {
-		return nil, err
+		m.log.WithContext(ctx).Warnf("RecordHistogram(%s, %f) failed: create new histogram failed: %v", name, incr, err)
+		return false
 	}
-
-	m.histogramMap[name] = histogram
-	return histogram, nil
+	histogram.Record(ctx, incr, options...)
+	return true
 }
 
-func (m *metrics) RecordHistogram(ctx context.Context, name string, incr float64, options ...metric.RecordOption) bool {
-	getHistogram := func() (metric.Float64Histogram, bool) {
-		m.histogramMux.RLock()
-		defer m.histogramMux.RUnlock()
-		histogram, ok := m.histogramMap[name]
-		return histogram, ok
-	}
+func (m *metrics) RegisterInt64Histogram(name string, int64Histogram metric.Int64Histogram) error {
+	return m.int64Histograms.register(name, int64Histogram)
+}
 
-	histogram, ok := getHistogram()
+func (m *metrics) RegisterNewInt64Histogram(name string, options ...metric.Int64HistogramOption) (metric.Int64Histogram, error) {
+	return m.int64Histograms.registerNew(name, func() (metric.Int64Histogram, error) {
+		return m.meter.Int64Histogram(name, options...)
+	})
+}
 
-	// histogram 不存在，则初始化一个新的
-	var err error
-	if !ok {
-		_, err = m.RegisterNewHistogram(name)
-		// 如果是已存在，则再读一次
-		if !errors.Is(err, histogramAlreadyExistsErr) && err != nil {
-			m.log.WithContext(ctx).Warnf("RecordHistogram(%s, %f) failed: create new histogram failed: %v", name, incr, err)
-			return false
-		}
-		histogram, _ = getHistogram()
+func (m *metrics) RecordInt64Histogram(ctx context.Context, name string, incr int64, options ...metric.RecordOption) bool {
+	// int64 histogram 不存在，则初始化一个新的
+	int64Histogram, err := m.int64Histograms.getOrNew(name, func() (metric.Int64Histogram, error) {
+		return m.meter.Int64Histogram(name)
+	})
+	if err != nil {
+		m.log.WithContext(ctx).Warnf("RecordInt64Histogram(%s, %d) failed: create new int64 histogram failed: %v", name, incr, err)
+		return false
 	}
-
-	histogram.Record(ctx, incr, options...)
+	int64Histogram.Record(ctx, incr, options...)
 	return true
 }
@@ -0,0 +1,142 @@
+package metrics
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jaggerzhuang1994/kratos-foundation/pkg/log"
+	"github.com/jaggerzhuang1994/kratos-foundation/proto/kratos_foundation_pb/config_pb"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"google.golang.org/protobuf/proto"
+)
+
+func newTestMetrics(t *testing.T) (*metrics, *sdkmetric.ManualReader) {
+	logConfig := log.NewDefaultConfig()
+	logConfig.File.Disable = proto.Bool(true)
+	logger, release, err := log.NewLogger(log.PresetKv{}, logConfig, log.NewHook(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(release)
+	reader := sdkmetric.NewManualReader()
+	mp := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	return newMetrics(log.NewLog(logger), &config_pb.Metrics{}, mp, mp.Meter("test")), reader
+}
+
+// collect 按指标名称返回采集到的数据点，同一指标的多个数据点累加
+func collect(t *testing.T, reader *sdkmetric.ManualReader) map[string]float64 {
+	var rm metricdata.ResourceMetrics
+	if err := reader.Collect(context.Background(), &rm); err != nil {
+		t.Fatal(err)
+	}
+	values := map[string]float64{}
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			switch data := m.Data.(type) {
+			case metricdata.Sum[int64]:
+				for _, dp := range data.DataPoints {
+					values[m.Name] += float64(dp.Value)
+				}
+			case metricdata.Sum[float64]:
+				for _, dp := range data.DataPoints {
+					values[m.Name] += dp.Value
+				}
+			case metricdata.Gauge[int64]:
+				for _, dp := range data.DataPoints {
+					values[m.Name] += float64(dp.Value)
+				}
+			case metricdata.Gauge[float64]:
+				for _, dp := range data.DataPoints {
+					values[m.Name] += dp.Value
+				}
+			case metricdata.Histogram[int64]:
+				for _, dp := range data.DataPoints {
+					values[m.Name] += float64(dp.Sum)
+				}
+			case metricdata.Histogram[float64]:
+				for _, dp := range data.DataPoints {
+					values[m.Name] += dp.Sum
+				}
+			}
+		}
+	}
+	return values
+}
+
+func TestMetrics(t *testing.T) {
+	m, reader := newTestMetrics(t)
+	ctx := context.Background()
+
+	m.AddFloat64Counter(ctx, "bytes", 1.5)
+	m.AddFloat64Counter(ctx, "bytes", 2)
+	m.AddUpDownCounter(ctx, "connections", 3)
+	m.AddUpDownCounter(ctx, "connections", -1)
+	m.AddFloat64UpDownCounter(ctx, "balance", -0.5)
+	m.RecordFloat64Gauge(ctx, "utilization", 0.75)
+	m.RecordInt64Histogram(ctx, "size", 10)
+	m.RecordInt64Histogram(ctx, "size", 20)
+	if _, err := m.RegisterNewUpDownCounter("connections"); err == nil {
+		t.Error("duplicated up down counter should return an error")
+	}
+
+	values := collect(t, reader)
+	for name, want := range map[string]float64{
+		"bytes":       3.5,
+		"connections": 2,
+		"balance":     -0.5,
+		"utilization": 0.75,
+		"size":        30,
+	} {
+		if values[name] != want {
+			t.Errorf("%s = %v, want %v", name, values[name], want)
+		}
+	}
+}
+
+func TestObservableMetrics(t *testing.T) {
+	m, reader := newTestMetrics(t)
+
+	// 同名指标的多个回调按属性区分
+	queue := func(name string, depth int64) metric.Int64Callback {
+		return func(_ context.Context, o metric.Int64Observer) error {
+			o.Observe(depth, metric.WithAttributes(attribute.String("queue", name)))
+			return nil
+		}
+	}
+	orders, err := m.RegisterObservableGauge("queue_depth", queue("orders", 3))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = m.RegisterObservableGauge("queue_depth", queue("emails", 4)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = m.RegisterFloat64ObservableCounter("cache_hits", func(_ context.Context, o metric.Float64Observer) error {
+		o.Observe(1.5)
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = m.RegisterObservableUpDownCounter("pool_in_use", func(_ context.Context, o metric.Int64Observer) error {
+		o.Observe(-2)
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	values := collect(t, reader)
+	if values["queue_depth"] != 7 || values["cache_hits"] != 1.5 || values["pool_in_use"] != -2 {
+		t.Errorf("values = %v", values)
+	}
+
+	// 取消回调后不再采集
+	if err = orders.Unregister(); err != nil {
+		t.Fatal(err)
+	}
+	values = collect(t, reader)
+	if values["queue_depth"] != 4 {
+		t.Errorf("queue_depth = %v", values["queue_depth"])
+	}
+}
@@ -0,0 +1,105 @@
+package metrics
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/embedded"
+)
+
+func (m *metrics) RegisterObservableGauge(name string, callback metric.Int64Callback, options ...metric.Int64ObservableGaugeOption) (metric.Registration, error) {
+	instrument, err := m.observableGauges.getOrNew(name, func() (metric.Int64ObservableGauge, error) {
+		return m.meter.Int64ObservableGauge(name, options...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return m.meter.RegisterCallback(int64Callback(instrument, callback), instrument)
+}
+
+func (m *metrics) RegisterFloat64ObservableGauge(name string, callback metric.Float64Callback, options ...metric.Float64ObservableGaugeOption) (metric.Registration, error) {
+	instrument, err := m.float64ObservableGauges.getOrNew(name, func() (metric.Float64ObservableGauge, error) {
+		return m.meter.Float64ObservableGauge(name, options...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return m.meter.RegisterCallback(float64Callback(instrument, callback), instrument)
+}
+
+func (m *metrics) RegisterObservableCounter(name string, callback metric.Int64Callback, options ...metric.Int64ObservableCounterOption) (metric.Registration, error) {
+	instrument, err := m.observableCounters.getOrNew(name, func() (metric.Int64ObservableCounter, error) {
+		return m.meter.Int64ObservableCounter(name, options...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return m.meter.RegisterCallback(int64Callback(instrument, callback), instrument)
+}
+
+func (m *metrics) RegisterFloat64ObservableCounter(name string, callback metric.Float64Callback, options ...metric.Float64ObservableCounterOption) (metric.Registration, error) {
+	instrument, err := m.float64ObservableCounters.getOrNew(name, func() (metric.Float64ObservableCounter, error) {
+		return m.meter.Float64ObservableCounter(name, options...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return m.meter.RegisterCallback(float64Callback(instrument, callback), instrument)
+}
+
+func (m *metrics) RegisterObservableUpDownCounter(name string, callback metric.Int64Callback, options ...metric.Int64ObservableUpDownCounterOption) (metric.Registration, error) {
+	instrument, err := m.observableUpDownCounters.getOrNew(name, func() (metric.Int64ObservableUpDownCounter, error) {
+		return m.meter.Int64ObservableUpDownCounter(name, options...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return m.meter.RegisterCallback(int64Callback(instrument, callback), instrument)
+}
+
+func (m *metrics) RegisterFloat64ObservableUpDownCounter(name string, callback metric.Float64Callback, options ...metric.Float64ObservableUpDownCounterOption) (metric.Registration, error) {
+	instrument, err := m.float64ObservableUpDownCounters.getOrNew(name, func() (metric.Float64ObservableUpDownCounter, error) {
+		return m.meter.Float64ObservableUpDownCounter(name, options...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return m.meter.RegisterCallback(float64Callback(instrument, callback), instrument)
+}
+
+// int64Callback 将单个异步指标的回调转换为 meter.RegisterCallback 的回调，
+// 通过 RegisterCallback 注册的回调可以取消，WithInt64Callback 注册的不能
+func int64Callback(observable metric.Int64Observable, callback metric.Int64Callback) metric.Callback {
+	return func(ctx context.Context, o metric.Observer) error {
+		return callback(ctx, int64Observer{observer: o, observable: observable})
+	}
+}
+
+// int64Observer 记录到指定的异步指标
+type int64Observer struct {
+	embedded.Int64Observer
+	observer   metric.Observer
+	observable metric.Int64Observable
+}
+
+func (o int64Observer) Observe(value int64, options ...metric.ObserveOption) {
+	o.observer.ObserveInt64(o.observable, value, options...)
+}
+
+// float64Callback 同 int64Callback
+func float64Callback(observable metric.Float64Observable, callback metric.Float64Callback) metric.Callback {
+	return func(ctx context.Context, o metric.Observer) error {
+		return callback(ctx, float64Observer{observer: o, observable: observable})
+	}
+}
+
+// float64Observer 记录到指定的异步指标
+type float64Observer struct {
+	embedded.Float64Observer
+	observer   metric.Observer
+	observable metric.Float64Observable
+}
+
+func (o float64Observer) Observe(value float64, options ...metric.ObserveOption) {
+	o.observer.ObserveFloat64(o.observable, value, options...)
+}
@@ -1,10 +1,12 @@
 package redis
 
 import (
+	"context"
 	"sync"
 	"time"
 
 	"github.com/jaggerzhuang1994/kratos-foundation/pkg/app_info"
+	"github.com/jaggerzhuang1994/kratos-foundation/pkg/health"
 	"github.com/jaggerzhuang1994/kratos-foundation/pkg/log"
 	"github.com/jaggerzhuang1994/kratos-foundation/pkg/metrics"
 	"github.com/jaggerzhuang1994/kratos-foundation/pkg/tracing"
@@ -43,6 +45,7 @@ func NewManager(
 	tracing tracing.Tracing,
 	metrics metrics.Metrics,
 	serviceAttributes app_info.ServiceAttributes,
+	hc health.Health,
 ) (Manager, func(), error) {
 	c := &manager{
 		Log:               log.WithModule("redis", config.GetLog()),
@@ -65,7 +68,13 @@ func NewManager(
 
 	c.defaultConn = defaultConnection
 
+	// 就绪检查：ping 默认连接
+	hc.Register("redis", func(ctx context.Context) error {
+		return defaultConnection.Ping(ctx).Err()
+	})
+
 	return c, func() {
+		hc.Unregister("redis")
 		c.release(0)
 	}, nil
 }
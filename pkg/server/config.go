@@ -1,6 +1,8 @@
 package server
 
 import (
+	"time"
+
 	"github.com/jaggerzhuang1994/kratos-foundation/pkg/config"
 	"github.com/jaggerzhuang1994/kratos-foundation/proto/kratos_foundation_pb/config_pb"
 	"google.golang.org/protobuf/proto"
@@ -13,8 +15,9 @@ type DefaultConfig Config
 
 func NewDefaultConfig() DefaultConfig {
 	return &config_pb.Server{
-		StopDelay:  durationpb.New(0),
-		Middleware: nil,
+		StopDelay:       durationpb.New(0),
+		StopQuietPeriod: durationpb.New(time.Second),
+		Middleware:      nil,
 		Http: &config_pb.HttpServerOption{
 			Disable:            proto.Bool(false),
 			Network:            proto.String("tcp"),
@@ -26,6 +29,16 @@ func NewDefaultConfig() DefaultConfig {
 				Disable: proto.Bool(false),
 				Path:    proto.String("/metrics"),
 			},
+			Health: &config_pb.HttpServerOption_Health{
+				Disable:       proto.Bool(false),
+				LivenessPath:  proto.String("/healthz"),
+				ReadinessPath: proto.String("/readyz"),
+			},
+			Admin: &config_pb.HttpServerOption_Admin{
+				Disable: proto.Bool(true),
+				Path:    proto.String("/admin"),
+				Token:   proto.String(""),
+			},
 		},
 		Grpc: &config_pb.GrpcServerOption{
 			Disable:           proto.Bool(false),
@@ -0,0 +1,147 @@
+// Package server 提供在途请求跟踪和停机排空功能
+//
+// 该文件定义了 Drainer，用于统计 HTTP/gRPC/WebSocket 的在途请求，
+// 并在停机时等待服务空闲后再停止服务器，替代固定时长的 sleep。
+//
+// 主要功能：
+//   - 通过中间件统计 HTTP/gRPC 在途请求
+//   - 统计 WebSocket 长连接
+//   - 停机时等待空闲静默期或最长等待时间
+package server
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+)
+
+// drainPollInterval 停机排空时检查在途请求的间隔
+const drainPollInterval = 50 * time.Millisecond
+
+// Drainer 在途请求跟踪器接口
+//
+// 该接口定义了在途请求的统计和停机排空方法：
+//   - Track: 开始跟踪一个请求，返回结束函数
+//   - Inflight: 获取当前在途请求数
+//   - Middleware: 用于 HTTP/gRPC 的跟踪中间件
+//   - Drain: 等待服务空闲
+//
+// 使用场景：
+//   - serverStopDelayWrapper 停机前等待在途请求完成
+//   - WebSocket 连接在整个生命周期内被视为在途请求
+type Drainer interface {
+	Track() (done func())
+	Inflight() int64
+	Middleware() middleware.Middleware
+	Drain(ctx context.Context, quietPeriod, maxWait time.Duration)
+}
+
+// drainer 在途请求跟踪器的实现
+//
+// 字段说明：
+//   - inflight: 当前在途请求数
+//   - lastActive: 最近一次请求开始或结束的时间（UnixNano）
+type drainer struct {
+	inflight   atomic.Int64
+	lastActive atomic.Int64
+}
+
+// NewDrainer 创建在途请求跟踪器
+//
+// 返回：
+//   - Drainer: 在途请求跟踪器实例
+//
+// 注意事项：
+//   - 所有服务器共享同一个跟踪器，停机时以整个进程是否空闲为准
+func NewDrainer() Drainer {
+	return &drainer{}
+}
+
+// Track 开始跟踪一个请求
+//
+// 返回：
+//   - done: 请求结束时调用，重复调用无副作用
+func (d *drainer) Track() (done func()) {
+	d.inflight.Add(1)
+	d.touch()
+	var once atomic.Bool
+	return func() {
+		if once.CompareAndSwap(false, true) {
+			d.inflight.Add(-1)
+			d.touch()
+		}
+	}
+}
+
+// Inflight 获取当前在途请求数
+func (d *drainer) Inflight() int64 {
+	return d.inflight.Load()
+}
+
+// Middleware 创建在途请求跟踪中间件
+//
+// 该中间件应放在中间件链的最外层，确保所有经过中间件的请求都被统计。
+//
+// 注意事项：
+//   - 直接通过 srv.Handle 注册的路由（metrics、健康检查）不经过中间件，不会被统计，
+//     因此探针请求不会影响停机静默期的判断
+func (d *drainer) Middleware() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req any) (any, error) {
+			done := d.Track()
+			defer done()
+			return handler(ctx, req)
+		}
+	}
+}
+
+// Drain 等待服务空闲
+//
+// 该方法在以下任一条件满足时返回：
+//  1. 没有在途请求，且距离最近一次请求活动（或开始排空）已超过 quietPeriod
+//  2. 已等待 maxWait
+//  3. ctx 结束（kratos 的 stop_timeout）
+//
+// 参数说明：
+//   - ctx: 停止上下文
+//   - quietPeriod: 空闲静默期，用于覆盖服务发现的同步延迟
+//   - maxWait: 最长等待时间
+func (d *drainer) Drain(ctx context.Context, quietPeriod, maxWait time.Duration) {
+	start := time.Now()
+	deadline := time.NewTimer(maxWait)
+	defer deadline.Stop()
+	ticker := time.NewTicker(drainPollInterval)
+	defer ticker.Stop()
+
+	for {
+		if d.Inflight() <= 0 && time.Since(d.idleSince(start)) >= quietPeriod {
+			return
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-deadline.C:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// touch 记录请求活动时间
+func (d *drainer) touch() {
+	d.lastActive.Store(time.Now().UnixNano())
+}
+
+// idleSince 计算空闲的起始时间
+//
+// 空闲时间从开始排空和最近一次请求活动中较晚的时间开始计算，
+// 保证排空开始后至少等待一个静默期，让服务发现有时间同步
+func (d *drainer) idleSince(start time.Time) time.Time {
+	last := time.Unix(0, d.lastActive.Load())
+	if last.After(start) {
+		return last
+	}
+	return start
+}
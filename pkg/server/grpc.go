@@ -7,6 +7,7 @@
 //   - 创建 gRPC 服务器实例
 //   - 配置网络、地址、端点
 //   - 应用服务器选项和中间件
+//   - 注册自定义健康检查服务（custom_health）
 //   - 注册到服务器管理器
 package server
 
@@ -14,6 +15,9 @@ import (
 	"net/url"
 
 	"github.com/go-kratos/kratos/v2/transport/grpc"
+	"github.com/jaggerzhuang1994/kratos-foundation/pkg/health"
+	grpchealth "google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
 )
 
 // GrpcServer gRPC 服务器类型别名
@@ -26,30 +30,44 @@ type GrpcServer = *grpc.Server
 // 该函数根据配置创建 gRPC 服务器，并将其注册到服务器管理器：
 //  1. 检查配置是否禁用 gRPC 服务器
 //  2. 使用服务器选项创建 gRPC 服务器实例
-//  3. 将服务器注册到 Register 管理器
+//  3. 如果配置了 custom_health，注册基于 health 模块的健康检查服务
+//  4. 将服务器注册到 Register 管理器
 //
 // 参数说明：
 //   - _: Setup 接口（未使用，仅用于确保依赖注入顺序）
 //   - config: 服务器配置（网络、地址、端点等）
 //   - opts: gRPC 服务器选项（通过 NewGrpcServerOptions 创建）
 //   - register: 服务器注册器，用于管理所有服务器实例
+//   - hc: 健康检查注册中心
 //
 // 返回：
 //   - GrpcServer: 配置好的 gRPC 服务器实例，如果禁用则返回 nil
 //
 // 注意事项：
 //   - 如果 config.Grpc.Disable 为 true，返回 nil
+//   - custom_health 为 false 时使用与 kratos 内置一致的健康服务（只反映进程启停状态），进入停机流程时返回 NOT_SERVING
 //   - 服务器会通过 Register.RegisterServer 注册到管理器
 func NewGrpcServer(
 	_ Setup, // Setup 接口（确保在服务器创建之前执行）
 	config Config, // 服务器配置
 	opts GrpcServerOptions, // gRPC 服务器选项
 	register Register, // 服务器注册器
+	hc health.Health, // 健康检查
 ) GrpcServer {
 	if config.GetGrpc().GetDisable() {
 		return nil
 	}
 	srv := grpc.NewServer(opts.Get()...)
+	if config.GetGrpc().GetCustomHealth() {
+		// 使用 health 模块的检查项替代内置的健康服务
+		grpc_health_v1.RegisterHealthServer(srv, health.NewGrpcHealthServer(hc))
+	} else {
+		// 与 kratos 内置的健康服务一致（只反映进程启停状态），
+		// 但在进入停机流程时（排空之前）就返回 NOT_SERVING，而不是等到 Stop
+		builtinHealth := grpchealth.NewServer()
+		grpc_health_v1.RegisterHealthServer(srv, builtinHealth)
+		hc.OnShutdown(builtinHealth.Shutdown)
+	}
 	register.RegisterServer(srv)
 	return srv
 }
@@ -84,7 +102,7 @@ type grpcServerOptions = sliceT[grpc.ServerOption]
 //   - Addr: 监听的地址（如 ":9000" 或 "0.0.0.0:9000"）
 //   - Endpoint: 对外暴露的端点（如 "grpc://service-name:9000"）
 //   - Timeout: 设置为 0，禁用默认超时，由中间件控制超时行为
-//   - CustomHealth: 是否使用自定义健康检查
+//   - CustomHealth: 是否使用自定义健康检查（由 NewGrpcServer 注册 health 模块的健康服务）
 //   - DisableReflection: 是否禁用 gRPC 反射服务
 //
 // 注意事项：
@@ -112,10 +130,8 @@ func NewGrpcServerOptions(config Config, middleware Middlewares) GrpcServerOptio
 	// 禁用默认超时，由中间件来控制超时行为
 	// 否则内部会有默认值 1s，可能导致长时间请求被中断
 	opts = append(opts, grpc.Timeout(0))
-	// 配置自定义健康检查
-	if conf.GetCustomHealth() {
-		opts = append(opts, grpc.CustomHealth())
-	}
+	// 不使用 kratos 内置的健康服务，由 NewGrpcServer 注册（custom_health 决定使用哪种）
+	opts = append(opts, grpc.CustomHealth())
 	// 禁用反射服务（生产环境建议禁用）
 	if conf.GetDisableReflection() {
 		opts = append(opts, grpc.DisableReflection())
@@ -7,14 +7,20 @@
 //   - 创建 HTTP 服务器实例
 //   - 配置网络、地址、端点
 //   - 注册 Prometheus 指标端点
+//   - 注册健康检查端点（/healthz、/readyz）
+//   - 挂载管理接口（/admin）
 //   - 应用服务器选项和中间件
 //   - 注册到服务器管理器
 package server
 
 import (
+	stdhttp "net/http"
 	"net/url"
+	"strings"
 
 	"github.com/go-kratos/kratos/v2/transport/http"
+	"github.com/jaggerzhuang1994/kratos-foundation/pkg/admin"
+	"github.com/jaggerzhuang1994/kratos-foundation/pkg/health"
 	"github.com/jaggerzhuang1994/kratos-foundation/pkg/transport"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 )
@@ -30,13 +36,17 @@ type HttpServer = *http.Server
 //  1. 检查配置是否禁用 HTTP 服务器
 //  2. 使用服务器选项创建 HTTP 服务器实例
 //  3. 注册 Prometheus 指标端点（如果启用）
-//  4. 将服务器注册到 Register 管理器
+//  4. 注册健康检查端点（如果启用）
+//  5. 挂载管理接口（如果启用）
+//  6. 将服务器注册到 Register 管理器
 //
 // 参数说明：
 //   - _: Setup 接口（未使用，仅用于确保依赖注入顺序）
 //   - config: 服务器配置（网络、地址、端点、指标等）
 //   - opts: HTTP 服务器选项（通过 NewHttpServerOptions 创建）
 //   - register: 服务器注册器，用于管理所有服务器实例
+//   - hc: 健康检查注册中心，用于提供 /healthz 和 /readyz
+//   - adm: 管理接口注册中心，挂载到 /admin 下
 //
 // 返回：
 //   - HttpServer: 配置好的 HTTP 服务器实例，如果禁用则返回 nil
@@ -44,12 +54,16 @@ type HttpServer = *http.Server
 // 注意事项：
 //   - 如果 config.Http.Disable 为 true，返回 nil
 //   - Prometheus 指标端点默认路径为 /metrics
+//   - 健康检查端点默认路径为 /healthz（存活）和 /readyz（就绪），失败时返回 503
+//   - 管理接口默认关闭，配置 token 后需要携带 Authorization: Bearer {token}
 //   - 服务器会通过 Register.RegisterServer 注册到管理器
 func NewHttpServer(
 	_ Setup, // Setup 接口（确保在服务器创建之前执行）
 	config Config, // 服务器配置
 	opts HttpServerOptions, // HTTP 服务器选项
 	register Register, // 服务器注册器
+	hc health.Health, // 健康检查
+	adm admin.Admin, // 管理接口
 ) HttpServer {
 	if config.GetHttp().GetDisable() {
 		return nil
@@ -59,6 +73,16 @@ func NewHttpServer(
 	if !config.GetHttp().GetMetrics().GetDisable() {
 		srv.Handle(config.GetHttp().GetMetrics().GetPath(), promhttp.Handler())
 	}
+	// 注册健康检查端点
+	if !config.GetHttp().GetHealth().GetDisable() {
+		srv.Handle(config.GetHttp().GetHealth().GetLivenessPath(), health.LivenessHandler(hc))
+		srv.Handle(config.GetHttp().GetHealth().GetReadinessPath(), health.ReadinessHandler(hc))
+	}
+	// 挂载管理接口
+	if conf := config.GetHttp().GetAdmin(); !conf.GetDisable() {
+		prefix := strings.TrimSuffix(conf.GetPath(), "/")
+		srv.HandlePrefix(prefix+"/", stdhttp.StripPrefix(prefix, admin.WithToken(conf.GetToken(), adm)))
+	}
 	register.RegisterServer(srv)
 	return srv
 }
@@ -2,6 +2,7 @@
 //
 // 该文件定义了中间件链的构建逻辑，按照最佳实践组装所有中间件。
 // 中间件按照以下顺序执行（从外到内）：
+//  0. Drain - 在途请求跟踪
 //  1. Recovery - 异常恢复
 //  2. Timeout - 超时控制
 //  3. Metadata - 元数据注入
@@ -10,11 +11,14 @@
 //  6. Logging - 日志记录
 //  7. Validator - 参数验证
 //  8. RateLimit - 限流控制
+//
+// 其中 Timeout 和 RateLimit 支持配置热更新（server.middleware 变更后立即生效）。
 package server
 
 import (
 	"github.com/go-kratos/kratos/v2/middleware"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
+	"github.com/jaggerzhuang1994/kratos-foundation/internal/middleware/dynamic"
 	"github.com/jaggerzhuang1994/kratos-foundation/internal/middleware/logging"
 	"github.com/jaggerzhuang1994/kratos-foundation/internal/middleware/metadata"
 	"github.com/jaggerzhuang1994/kratos-foundation/internal/middleware/metrics"
@@ -22,7 +26,9 @@ import (
 	"github.com/jaggerzhuang1994/kratos-foundation/internal/middleware/timeout"
 	"github.com/jaggerzhuang1994/kratos-foundation/internal/middleware/tracing"
 	"github.com/jaggerzhuang1994/kratos-foundation/internal/middleware/validator"
+	config2 "github.com/jaggerzhuang1994/kratos-foundation/pkg/config"
 	"github.com/jaggerzhuang1994/kratos-foundation/pkg/log"
+	"google.golang.org/protobuf/proto"
 )
 
 // Middlewares 中间件链的类型别名
@@ -38,6 +44,7 @@ type middlewares = sliceT[middleware.Middleware]
 // 该函数按照最佳实践组装所有中间件，每个中间件都有特定的职责：
 //
 // 中间件顺序（执行顺序从外到内）：
+//  0. Drain: 统计在途请求，用于停机排空
 //  1. Recovery: 捕获 panic，防止服务崩溃
 //  2. Timeout: 控制请求超时时间
 //  3. Metadata: 将 HTTP/gRPC 元数据注入到上下文
@@ -52,6 +59,9 @@ type middlewares = sliceT[middleware.Middleware]
 //   - log: 日志记录器
 //   - metrics_: 指标收集器
 //   - tracing_: 链路追踪器
+//   - drainer: 在途请求跟踪器
+//   - watch: 配置热更新，用于订阅 server 配置段
+//   - defaultConfig: 默认配置，热更新时与新配置合并
 //
 // 返回：
 //   - Middlewares: 包含所有中间件的链，按正确顺序组织
@@ -60,15 +70,27 @@ type middlewares = sliceT[middleware.Middleware]
 //   - 中间件顺序很重要，请勿随意更改
 //   - 某些中间件可能为 nil（配置禁用或初始化失败）
 //   - Metrics 中间件初始化失败只会记录警告，不会中断服务启动
+//   - Timeout 和 RateLimit 使用 dynamic.Middleware 包装，配置变更时替换内部实现
+//   - RateLimit 参数变更时会重建限流器，之前的统计窗口会被丢弃
 func NewMiddlewares(
 	config Config,
 	log log.Log,
 	metrics_ metrics.Metrics,
 	tracing_ tracing.Tracing,
+	drainer Drainer,
+	watch config2.Watch,
+	defaultConfig DefaultConfig,
 ) Middlewares {
 	var m middlewares
 	conf := config.GetMiddleware()
 
+	// ============================================================
+	// 第 0 层：在途请求跟踪中间件
+	// ============================================================
+	// 放在最外层，统计所有请求（包括 panic 的请求）
+	// 停机时等待在途请求完成后再停止服务器
+	m.Add(drainer.Middleware())
+
 	// ============================================================
 	// 第 1 层：异常恢复中间件
 	// ============================================================
@@ -81,7 +103,8 @@ func NewMiddlewares(
 	// ============================================================
 	// 控制请求的最大执行时间
 	// 超时后自动取消请求，返回超时错误
-	m.Add(timeout.Server(conf.GetTimeout()))
+	timeoutMiddleware := dynamic.New(timeout.Server(conf.GetTimeout()))
+	m.Add(timeoutMiddleware.Middleware())
 
 	// ============================================================
 	// 第 3 层：元数据中间件
@@ -138,9 +161,26 @@ func NewMiddlewares(
 	// ============================================================
 	// 基于令牌桶算法的请求限流
 	// 防止服务过载，保护服务稳定性
-	if mm := ratelimit.Server(conf.GetRateLimit()); mm != nil {
-		m.Add(mm)
-	}
+	// 始终添加，方便热更新时从禁用切换为启用
+	rateLimitMiddleware := dynamic.New(ratelimit.Server(conf.GetRateLimit()))
+	m.Add(rateLimitMiddleware.Middleware())
+
+	// ============================================================
+	// 配置热更新
+	// ============================================================
+	watch.Subscribe(config2.SectionServer, func(c, old config2.KratosFoundationConfig) error {
+		conf := NewConfig(c, defaultConfig).GetMiddleware()
+		oldConf := NewConfig(old, defaultConfig).GetMiddleware()
+		if !proto.Equal(conf.GetTimeout(), oldConf.GetTimeout()) {
+			timeoutMiddleware.Update(timeout.Server(conf.GetTimeout()))
+			log.Info("server timeout middleware reloaded")
+		}
+		if !proto.Equal(conf.GetRateLimit(), oldConf.GetRateLimit()) {
+			rateLimitMiddleware.Update(ratelimit.Server(conf.GetRateLimit()))
+			log.Info("server ratelimit middleware reloaded")
+		}
+		return nil
+	})
 
 	return &m
 }
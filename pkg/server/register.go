@@ -5,7 +5,7 @@
 //
 // 主要功能：
 //   - 注册和管理多个服务器实例
-//   - 支持延迟停止机制（优雅停机）
+//   - 支持排空停止机制（等待在途请求完成后优雅停机）
 //   - 为所有服务器提供统一的管理接口
 package server
 
@@ -14,6 +14,7 @@ import (
 	"time"
 
 	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/jaggerzhuang1994/kratos-foundation/pkg/health"
 	"github.com/jaggerzhuang1994/kratos-foundation/pkg/utils"
 )
 
@@ -39,6 +40,8 @@ type Register interface {
 type register struct {
 	config  Config             // 服务器配置
 	state   *DisableState      // 禁用的服务
+	drainer Drainer            // 在途请求跟踪器
+	health  health.Health      // 健康检查，排空前进入停机流程
 	servers []transport.Server // 已注册的服务器列表
 }
 
@@ -47,7 +50,10 @@ type register struct {
 // 该函数创建一个服务器注册器实例，用于管理所有服务器。
 //
 // 参数说明：
-//   - config: 服务器配置（包含 StopDelay 配置）
+//   - config: 服务器配置（包含 StopDelay、StopQuietPeriod 配置）
+//   - state: 禁用的服务
+//   - drainer: 在途请求跟踪器，用于停机排空
+//   - hc: 健康检查，排空前标记为停机，就绪检查和 gRPC 健康服务返回不可用
 //
 // 返回：
 //   - Register: 服务器注册器实例
@@ -58,10 +64,14 @@ type register struct {
 func NewRegister(
 	config Config,
 	state *DisableState,
+	drainer Drainer,
+	hc health.Health,
 ) Register {
 	r := &register{
-		config: config,
-		state:  state,
+		config:  config,
+		state:   state,
+		drainer: drainer,
+		health:  hc,
 	}
 	return r
 }
@@ -71,12 +81,13 @@ func NewRegister(
 // 该方法将服务器添加到注册器中，并根据配置决定是否包装服务器：
 //   - 如果服务器实现了 Endpointer 接口
 //   - 且配置了 StopDelay > 0
-//   - 则包装服务器，实现延迟停止机制
+//   - 则包装服务器，实现排空停止机制
 //
-// 延迟停止机制的作用：
-//   - 在服务注销后，延迟停止服务器
+// 排空停止机制的作用：
+//   - 在服务注销后，等待在途请求完成且空闲一段时间后再停止服务器
 //   - 避免其他服务还在使用旧连接时请求失败
 //   - 配合服务发现中心实现平滑下线
+//   - 没有流量时无需等待完整的 StopDelay
 //
 // 参数说明：
 //   - server: 要注册的服务器实例（HTTP、gRPC、WebSocket、Job 等）
@@ -95,9 +106,12 @@ func (s *register) RegisterServer(server transport.Server) {
 	// 避免注册到服务中心停止服务导致其他服务无法请求
 	if endpointer, ok := server.(transport.Endpointer); ok && s.config.GetStopDelay().AsDuration() > 0 {
 		s.servers = append(s.servers, &serverStopDelayWrapper{
-			Server:     server,
-			Endpointer: endpointer,
-			stopDelay:  s.config.GetStopDelay().AsDuration(),
+			Server:      server,
+			Endpointer:  endpointer,
+			drainer:     s.drainer,
+			health:      s.health,
+			stopDelay:   s.config.GetStopDelay().AsDuration(),
+			quietPeriod: s.config.GetStopQuietPeriod().AsDuration(),
 		})
 	} else {
 		s.servers = append(s.servers, server)
@@ -143,46 +157,56 @@ func (s *register) GetServers() []transport.Server {
 	return servers
 }
 
-// serverStopDelayWrapper 服务器延迟停止包装器
+// serverStopDelayWrapper 服务器排空停止包装器
 //
-// 该结构体包装了原始服务器，实现了延迟停止机制：
-//   - 在 Stop 方法被调用时，先等待 stopDelay 时间
+// 该结构体包装了原始服务器，实现了排空停止机制：
+//   - 在 Stop 方法被调用时，等待服务空闲（没有在途请求且持续 quietPeriod）
+//   - 最长等待 stopDelay 或者 ctx 结束
 //   - 然后再调用原始服务器的 Stop 方法
 //
 // 使用场景：
 //   - 配合服务发现中心实现优雅下线
-//   - 在服务注销后，继续服务一段时间
+//   - 在服务注销后，继续服务直到流量排空
 //   - 让其他服务有时间更新服务列表
 //
 // 字段说明：
 //   - Server: 原始服务器实例
 //   - Endpointer: 原始服务器的端点信息
-//   - stopDelay: 延迟停止的时间长度
+//   - drainer: 在途请求跟踪器
+//   - health: 健康检查
+//   - stopDelay: 最长等待时间
+//   - quietPeriod: 空闲静默期
 type serverStopDelayWrapper struct {
 	transport.Server
 	transport.Endpointer
-	stopDelay time.Duration
+	drainer     Drainer
+	health      health.Health
+	stopDelay   time.Duration
+	quietPeriod time.Duration
 }
 
-// Stop 延迟停止服务器
+// Stop 排空后停止服务器
 //
-// 该方法实现了延迟停止逻辑：
-//  1. 等待 stopDelay 时间
-//  2. 调用原始服务器的 Stop 方法
+// 该方法实现了排空停止逻辑：
+//  1. 标记健康检查进入停机流程，负载均衡不再转发新流量
+//  2. 等待服务空闲，最长等待 stopDelay，ctx 结束时立即停止等待
+//  3. 调用原始服务器的 Stop 方法
 //
 // 参数说明：
-//   - ctx: 停止上下文（未使用，延迟时间是固定的）
+//   - ctx: 停止上下文（携带 app.stop_timeout 的截止时间）
 //
 // 返回：
 //   - error: 原始服务器 Stop 方法的返回值
 //
 // 注意事项：
-//   - 该方法会阻塞 stopDelay 时间
-//   - 在延迟期间，服务器继续接受和处理请求
-//   - 延迟时间应该大于服务发现的刷新间隔
+//   - 在等待期间，服务器继续接受和处理请求
+//   - 就绪检查在服务注销时（BeforeStop）已经失败，负载均衡会逐步摘除流量
+//   - quietPeriod 应该大于服务发现的刷新间隔
 func (s *serverStopDelayWrapper) Stop(ctx context.Context) error {
+	// 通常已经在 BeforeStop 中标记，这里保证排空前健康服务（包括 custom_health 为 false 时的 gRPC 健康服务）返回不可用
+	s.health.Shutdown()
 	if s.stopDelay > 0 {
-		time.Sleep(s.stopDelay)
+		s.drainer.Drain(ctx, s.quietPeriod, s.stopDelay)
 	}
 	return s.Server.Stop(ctx)
 }
@@ -8,6 +8,7 @@
 //   - 连接生命周期管理
 //   - 消息处理（文本和二进制）
 //   - 错误处理和日志记录
+//   - 连接计入在途请求，停机时等待连接关闭
 //
 // 使用方式：
 //
@@ -85,8 +86,9 @@ const (
 //
 // 该结构体管理 WebSocket 连接的路由和处理，集成了日志记录功能。
 type websocketServer struct {
-	log    log.Log    // 日志记录器
-	router HttpRouter // HTTP 路由器，用于注册 WebSocket 路由
+	log     log.Log    // 日志记录器
+	router  HttpRouter // HTTP 路由器，用于注册 WebSocket 路由
+	drainer Drainer    // 在途请求跟踪器
 }
 
 // NewWebsocketServer 创建 WebSocket 服务器
@@ -98,6 +100,7 @@ type websocketServer struct {
 //   - _: Setup 接口（未使用，仅用于确保依赖注入顺序）
 //   - log: 日志记录器
 //   - httpServer: HTTP 服务器实例，用于获取路由器
+//   - drainer: 在途请求跟踪器，WebSocket 连接在关闭前都计为在途请求
 //
 // 返回：
 //   - WebsocketServer: WebSocket 服务器实例
@@ -109,8 +112,10 @@ func NewWebsocketServer(
 	_ Setup, // Setup 接口（确保在服务器创建之前执行）
 	log log.Log, // 日志记录器
 	httpServer HttpServer, // HTTP 服务器实例
+	drainer Drainer, // 在途请求跟踪器
 ) WebsocketServer {
 	srv := &websocketServer{
+		drainer: drainer,
 		log: log.WithModule("server/websocket").With(
 			"client", log2.Valuer(func(ctx context.Context) any {
 				request, ok := http.RequestFromServerContext(ctx)
@@ -146,7 +151,7 @@ func NewWebsocketServer(
 //  1. 检查 HTTP 服务器是否已初始化
 //  2. 使用 Upgrader 将 HTTP 连接升级为 WebSocket 连接
 //  3. 创建 WebSocket 客户端实例
-//  4. 在独立的 goroutine 中处理消息循环
+//  4. 在独立的 goroutine 中处理消息循环（连接关闭前计为在途请求）
 //
 // 注意事项：
 //   - 如果 HTTP 服务器未初始化，会记录警告并返回
@@ -181,7 +186,12 @@ func (s *websocketServer) Handle(path string, handler any, optionalUpgrader ...U
 				return nil, err
 			}
 			// 处理请求（在独立的 goroutine 中运行）
-			go client.resolve()
+			// 连接存续期间计为在途请求，停机时等待连接关闭
+			done := s.drainer.Track()
+			go func() {
+				defer done()
+				client.resolve()
+			}()
 			return nil, nil
 		})
 		_, err := h(ctx, r)
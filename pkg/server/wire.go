@@ -10,6 +10,7 @@
 //   - gRPC 服务器：NewGrpcServerOptions, NewGrpcServer
 //   - WebSocket 服务器：NewWebsocketServer
 //   - 服务器管理：NewRegister
+//   - 停机排空：NewDrainer
 //
 // 使用方式：
 //
@@ -43,6 +44,9 @@ var ProviderSet = wire.NewSet(
 	NewDefaultConfig, // 默认配置
 	NewConfig,        // 服务器配置（从配置文件加载）
 
+	// 停机排空
+	NewDrainer, // 在途请求跟踪器
+
 	// 中间件
 	NewMiddlewares, // 服务器中间件链
 
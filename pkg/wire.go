@@ -2,6 +2,7 @@ package kratos_foundation
 
 import (
 	"github.com/google/wire"
+	"github.com/jaggerzhuang1994/kratos-foundation/pkg/admin"
 	"github.com/jaggerzhuang1994/kratos-foundation/pkg/app"
 	"github.com/jaggerzhuang1994/kratos-foundation/pkg/app_info"
 	"github.com/jaggerzhuang1994/kratos-foundation/pkg/client"
@@ -10,6 +11,7 @@ import (
 	"github.com/jaggerzhuang1994/kratos-foundation/pkg/context"
 	"github.com/jaggerzhuang1994/kratos-foundation/pkg/database"
 	"github.com/jaggerzhuang1994/kratos-foundation/pkg/discovery"
+	"github.com/jaggerzhuang1994/kratos-foundation/pkg/health"
 	"github.com/jaggerzhuang1994/kratos-foundation/pkg/job"
 	"github.com/jaggerzhuang1994/kratos-foundation/pkg/log"
 	"github.com/jaggerzhuang1994/kratos-foundation/pkg/metrics"
@@ -26,6 +28,10 @@ var ProviderSet = wire.NewSet(
 	app_info.ProviderSet,
 	// 日志
 	log.ProviderSet,
+	// 健康检查
+	health.ProviderSet,
+	// 管理接口
+	admin.ProviderSet,
 	// consul
 	consul.ProviderSet,
 	// 配置
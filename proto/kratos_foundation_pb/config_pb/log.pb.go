@@ -10,6 +10,7 @@ import (
 	_ "github.com/jaggerzhuang1994/kratos-foundation/cmd/protoc-gen-jsonschema/pkg/proto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -32,7 +33,7 @@ type Log struct {
 	FilterEmpty *bool `protobuf:"varint,2,opt,name=filter_empty,json=filterEmpty,proto3,oneof" json:"filter_empty,omitempty"`
 	// 打印日志过滤哪些keys
 	FilterKeys []string `protobuf:"bytes,3,rep,name=filter_keys,json=filterKeys,proto3" json:"filter_keys,omitempty"`
-	// 时间格式化 默认为 time.RFC3339
+	// 时间格式化 默认为 time.RFC3339，可以被输出目标的 encoder.time_format 覆盖
 	TimeFormat *string `protobuf:"bytes,4,opt,name=time_format,json=timeFormat,proto3,oneof" json:"time_format,omitempty"`
 	// 标准输出流日志
 	Std *StdLogger `protobuf:"bytes,5,opt,name=std,proto3,oneof" json:"std,omitempty"`
@@ -40,6 +41,17 @@ type Log struct {
 	File *FileLogger `protobuf:"bytes,6,opt,name=file,proto3,oneof" json:"file,omitempty"`
 	// 预置哪些 kv，默认 ts, service.id, service.name, service.version, trace.id, span.id, caller
 	Preset []string `protobuf:"bytes,7,rep,name=preset,proto3" json:"preset,omitempty"`
+	// 日志采样，抑制短时间内大量相同的日志
+	Sampling *LogSampling `protobuf:"bytes,8,opt,name=sampling,proto3,oneof" json:"sampling,omitempty"`
+	// 脱敏规则，对所有输出目标生效（包括嵌套的 map 和 logging 中间件记录的请求参数）
+	RedactRules []*LogRedactRule `protobuf:"bytes,9,rep,name=redact_rules,json=redactRules,proto3" json:"redact_rules,omitempty"`
+	// OTLP 日志，发送到 OpenTelemetry Collector 等
+	Otlp *OtlpLogger `protobuf:"bytes,10,opt,name=otlp,proto3,oneof" json:"otlp,omitempty"`
+	// 额外的文件日志，例如 warn 及以上级别单独输出到 error.log，未配置的字段使用 file 的默认值，path 必填且不能重复
+	Files []*FileLogger `protobuf:"bytes,11,rep,name=files,proto3" json:"files,omitempty"`
+	// 脱敏规则 mask: hash 使用的 HMAC-SHA256 密钥，使用 hash 时必填
+	// 建议通过占位符注入（例如 ${env:LOG_REDACT_HASH_KEY}），多个实例使用相同的密钥才能关联相同的值
+	RedactHashKey *string `protobuf:"bytes,12,opt,name=redact_hash_key,json=redactHashKey,proto3,oneof" json:"redact_hash_key,omitempty"`
 }
 
 func (x *Log) Reset() {
@@ -123,6 +135,205 @@ func (x *Log) GetPreset() []string {
 	return nil
 }
 
+func (x *Log) GetSampling() *LogSampling {
+	if x != nil {
+		return x.Sampling
+	}
+	return nil
+}
+
+func (x *Log) GetRedactRules() []*LogRedactRule {
+	if x != nil {
+		return x.RedactRules
+	}
+	return nil
+}
+
+func (x *Log) GetOtlp() *OtlpLogger {
+	if x != nil {
+		return x.Otlp
+	}
+	return nil
+}
+
+func (x *Log) GetFiles() []*FileLogger {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+func (x *Log) GetRedactHashKey() string {
+	if x != nil && x.RedactHashKey != nil {
+		return *x.RedactHashKey
+	}
+	return ""
+}
+
+type LogRedactRule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 匹配的 key，支持通配符，不区分大小写（例如 *password*、args.user.phone）
+	// 嵌套字段的 key 为以 . 分隔的路径，只写最后一段时匹配任意层级
+	Keys []string `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	// 匹配 value 的正则，只替换匹配的部分（例如银行卡号 \b\d{16,19}\b）
+	// 同时配置 keys 时只对匹配 key 的 value 生效；只配置 keys 时替换整个 value
+	Values []string `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`
+	// 掩码方式（默认 full）
+	//   - full: 替换为 ******
+	//   - partial: 保留首尾各 1/4（最多 4 个字符），其余替换为 *
+	//   - hash: 替换为 hmac: 加 HMAC-SHA256（密钥为 log.redact_hash_key）的前 16 位，相同的值可以关联
+	Mask *string `protobuf:"bytes,3,opt,name=mask,proto3,oneof" json:"mask,omitempty"`
+}
+
+func (x *LogRedactRule) Reset() {
+	*x = LogRedactRule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_pb_log_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LogRedactRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogRedactRule) ProtoMessage() {}
+
+func (x *LogRedactRule) ProtoReflect() protoreflect.Message {
+	mi := &file_config_pb_log_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogRedactRule.ProtoReflect.Descriptor instead.
+func (*LogRedactRule) Descriptor() ([]byte, []int) {
+	return file_config_pb_log_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *LogRedactRule) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+func (x *LogRedactRule) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *LogRedactRule) GetMask() string {
+	if x != nil && x.Mask != nil {
+		return *x.Mask
+	}
+	return ""
+}
+
+type LogSampling struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 是否禁用采样（默认禁用）
+	Disable *bool `protobuf:"varint,1,opt,name=disable,proto3,oneof" json:"disable,omitempty"`
+	// 统计周期（默认 1s）
+	Interval *durationpb.Duration `protobuf:"bytes,2,opt,name=interval,proto3,oneof" json:"interval,omitempty"`
+	// 每个统计周期内，相同的日志先输出的条数（默认 100）
+	First *int32 `protobuf:"varint,3,opt,name=first,proto3,oneof" json:"first,omitempty"`
+	// 超过 first 后，每 thereafter 条输出 1 条，0 表示全部丢弃（默认 100）
+	Thereafter *int32 `protobuf:"varint,4,opt,name=thereafter,proto3,oneof" json:"thereafter,omitempty"`
+	// 输出被抑制日志汇总的周期（默认 10s）
+	SummaryInterval *durationpb.Duration `protobuf:"bytes,5,opt,name=summary_interval,json=summaryInterval,proto3,oneof" json:"summary_interval,omitempty"`
+	// 日志级别和这些 key 的值都相同时视为相同的日志（默认 module, msg）
+	Keys []string `protobuf:"bytes,6,rep,name=keys,proto3" json:"keys,omitempty"`
+}
+
+func (x *LogSampling) Reset() {
+	*x = LogSampling{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_pb_log_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LogSampling) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogSampling) ProtoMessage() {}
+
+func (x *LogSampling) ProtoReflect() protoreflect.Message {
+	mi := &file_config_pb_log_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogSampling.ProtoReflect.Descriptor instead.
+func (*LogSampling) Descriptor() ([]byte, []int) {
+	return file_config_pb_log_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *LogSampling) GetDisable() bool {
+	if x != nil && x.Disable != nil {
+		return *x.Disable
+	}
+	return false
+}
+
+func (x *LogSampling) GetInterval() *durationpb.Duration {
+	if x != nil {
+		return x.Interval
+	}
+	return nil
+}
+
+func (x *LogSampling) GetFirst() int32 {
+	if x != nil && x.First != nil {
+		return *x.First
+	}
+	return 0
+}
+
+func (x *LogSampling) GetThereafter() int32 {
+	if x != nil && x.Thereafter != nil {
+		return *x.Thereafter
+	}
+	return 0
+}
+
+func (x *LogSampling) GetSummaryInterval() *durationpb.Duration {
+	if x != nil {
+		return x.SummaryInterval
+	}
+	return nil
+}
+
+func (x *LogSampling) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
 type StdLogger struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -134,12 +345,18 @@ type StdLogger struct {
 	Level *string `protobuf:"bytes,2,opt,name=level,proto3,oneof" json:"level,omitempty"`
 	// 打印日志过滤哪些keys（默认值为 service.id, service.name, service.version）
 	FilterKeys []string `protobuf:"bytes,3,rep,name=filter_keys,json=filterKeys,proto3" json:"filter_keys,omitempty"`
+	// 异步写入
+	Async *LogAsync `protobuf:"bytes,4,opt,name=async,proto3,oneof" json:"async,omitempty"`
+	// 编码（本地环境默认 console，其他环境默认 logfmt）
+	Encoding *string `protobuf:"bytes,5,opt,name=encoding,proto3,oneof" json:"encoding,omitempty"`
+	// 编码配置
+	Encoder *LogEncoder `protobuf:"bytes,6,opt,name=encoder,proto3,oneof" json:"encoder,omitempty"`
 }
 
 func (x *StdLogger) Reset() {
 	*x = StdLogger{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_pb_log_proto_msgTypes[1]
+		mi := &file_config_pb_log_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -152,7 +369,7 @@ func (x *StdLogger) String() string {
 func (*StdLogger) ProtoMessage() {}
 
 func (x *StdLogger) ProtoReflect() protoreflect.Message {
-	mi := &file_config_pb_log_proto_msgTypes[1]
+	mi := &file_config_pb_log_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -165,7 +382,7 @@ func (x *StdLogger) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StdLogger.ProtoReflect.Descriptor instead.
 func (*StdLogger) Descriptor() ([]byte, []int) {
-	return file_config_pb_log_proto_rawDescGZIP(), []int{1}
+	return file_config_pb_log_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *StdLogger) GetDisable() bool {
@@ -189,6 +406,27 @@ func (x *StdLogger) GetFilterKeys() []string {
 	return nil
 }
 
+func (x *StdLogger) GetAsync() *LogAsync {
+	if x != nil {
+		return x.Async
+	}
+	return nil
+}
+
+func (x *StdLogger) GetEncoding() string {
+	if x != nil && x.Encoding != nil {
+		return *x.Encoding
+	}
+	return ""
+}
+
+func (x *StdLogger) GetEncoder() *LogEncoder {
+	if x != nil {
+		return x.Encoder
+	}
+	return nil
+}
+
 type FileLogger struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -204,12 +442,20 @@ type FileLogger struct {
 	Path *string `protobuf:"bytes,4,opt,name=path,proto3,oneof" json:"path,omitempty"`
 	// 文件拆分
 	Rotating *FileRotating `protobuf:"bytes,5,opt,name=rotating,proto3,oneof" json:"rotating,omitempty"`
+	// 异步写入
+	Async *LogAsync `protobuf:"bytes,6,opt,name=async,proto3,oneof" json:"async,omitempty"`
+	// 编码（默认 json）
+	Encoding *string `protobuf:"bytes,7,opt,name=encoding,proto3,oneof" json:"encoding,omitempty"`
+	// 编码配置
+	Encoder *LogEncoder `protobuf:"bytes,8,opt,name=encoder,proto3,oneof" json:"encoder,omitempty"`
+	// 最大日志级别（默认不限制），与 level 一起限定输出的级别范围
+	MaxLevel *string `protobuf:"bytes,9,opt,name=max_level,json=maxLevel,proto3,oneof" json:"max_level,omitempty"`
 }
 
 func (x *FileLogger) Reset() {
 	*x = FileLogger{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_pb_log_proto_msgTypes[2]
+		mi := &file_config_pb_log_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -222,7 +468,7 @@ func (x *FileLogger) String() string {
 func (*FileLogger) ProtoMessage() {}
 
 func (x *FileLogger) ProtoReflect() protoreflect.Message {
-	mi := &file_config_pb_log_proto_msgTypes[2]
+	mi := &file_config_pb_log_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -235,7 +481,7 @@ func (x *FileLogger) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FileLogger.ProtoReflect.Descriptor instead.
 func (*FileLogger) Descriptor() ([]byte, []int) {
-	return file_config_pb_log_proto_rawDescGZIP(), []int{2}
+	return file_config_pb_log_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *FileLogger) GetDisable() bool {
@@ -273,6 +519,355 @@ func (x *FileLogger) GetRotating() *FileRotating {
 	return nil
 }
 
+func (x *FileLogger) GetAsync() *LogAsync {
+	if x != nil {
+		return x.Async
+	}
+	return nil
+}
+
+func (x *FileLogger) GetEncoding() string {
+	if x != nil && x.Encoding != nil {
+		return *x.Encoding
+	}
+	return ""
+}
+
+func (x *FileLogger) GetEncoder() *LogEncoder {
+	if x != nil {
+		return x.Encoder
+	}
+	return nil
+}
+
+func (x *FileLogger) GetMaxLevel() string {
+	if x != nil && x.MaxLevel != nil {
+		return *x.MaxLevel
+	}
+	return ""
+}
+
+type OtlpLogger struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 是否禁用 otlp logger（默认禁用）
+	Disable *bool `protobuf:"varint,1,opt,name=disable,proto3,oneof" json:"disable,omitempty"`
+	// 最小日志级别（默认值为父级level）
+	Level *string `protobuf:"bytes,2,opt,name=level,proto3,oneof" json:"level,omitempty"`
+	// 打印日志过滤哪些keys（默认值为 service.id, service.name, service.version，服务信息已作为 resource 属性上报）
+	FilterKeys []string `protobuf:"bytes,3,rep,name=filter_keys,json=filterKeys,proto3" json:"filter_keys,omitempty"`
+	// 导出配置，与 tracing.exporter 相同（默认地址 http://localhost:4318/v1/logs）
+	Exporter *Exporter `protobuf:"bytes,4,opt,name=exporter,proto3,oneof" json:"exporter,omitempty"`
+	// 批量导出
+	Batch *OtlpLogBatch `protobuf:"bytes,5,opt,name=batch,proto3,oneof" json:"batch,omitempty"`
+}
+
+func (x *OtlpLogger) Reset() {
+	*x = OtlpLogger{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_pb_log_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OtlpLogger) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OtlpLogger) ProtoMessage() {}
+
+func (x *OtlpLogger) ProtoReflect() protoreflect.Message {
+	mi := &file_config_pb_log_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OtlpLogger.ProtoReflect.Descriptor instead.
+func (*OtlpLogger) Descriptor() ([]byte, []int) {
+	return file_config_pb_log_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *OtlpLogger) GetDisable() bool {
+	if x != nil && x.Disable != nil {
+		return *x.Disable
+	}
+	return false
+}
+
+func (x *OtlpLogger) GetLevel() string {
+	if x != nil && x.Level != nil {
+		return *x.Level
+	}
+	return ""
+}
+
+func (x *OtlpLogger) GetFilterKeys() []string {
+	if x != nil {
+		return x.FilterKeys
+	}
+	return nil
+}
+
+func (x *OtlpLogger) GetExporter() *Exporter {
+	if x != nil {
+		return x.Exporter
+	}
+	return nil
+}
+
+func (x *OtlpLogger) GetBatch() *OtlpLogBatch {
+	if x != nil {
+		return x.Batch
+	}
+	return nil
+}
+
+type OtlpLogBatch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 队列大小，队列满时丢弃日志（默认 2048）
+	MaxQueueSize *int32 `protobuf:"varint,1,opt,name=max_queue_size,json=maxQueueSize,proto3,oneof" json:"max_queue_size,omitempty"`
+	// 导出间隔（默认 1s）
+	ExportInterval *durationpb.Duration `protobuf:"bytes,2,opt,name=export_interval,json=exportInterval,proto3,oneof" json:"export_interval,omitempty"`
+	// 每次导出的最大条数（默认 512）
+	MaxExportBatchSize *int32 `protobuf:"varint,3,opt,name=max_export_batch_size,json=maxExportBatchSize,proto3,oneof" json:"max_export_batch_size,omitempty"`
+}
+
+func (x *OtlpLogBatch) Reset() {
+	*x = OtlpLogBatch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_pb_log_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OtlpLogBatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OtlpLogBatch) ProtoMessage() {}
+
+func (x *OtlpLogBatch) ProtoReflect() protoreflect.Message {
+	mi := &file_config_pb_log_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OtlpLogBatch.ProtoReflect.Descriptor instead.
+func (*OtlpLogBatch) Descriptor() ([]byte, []int) {
+	return file_config_pb_log_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *OtlpLogBatch) GetMaxQueueSize() int32 {
+	if x != nil && x.MaxQueueSize != nil {
+		return *x.MaxQueueSize
+	}
+	return 0
+}
+
+func (x *OtlpLogBatch) GetExportInterval() *durationpb.Duration {
+	if x != nil {
+		return x.ExportInterval
+	}
+	return nil
+}
+
+func (x *OtlpLogBatch) GetMaxExportBatchSize() int32 {
+	if x != nil && x.MaxExportBatchSize != nil {
+		return *x.MaxExportBatchSize
+	}
+	return 0
+}
+
+type LogEncoder struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 时间格式（默认为 log.time_format）
+	TimeFormat *string `protobuf:"bytes,1,opt,name=time_format,json=timeFormat,proto3,oneof" json:"time_format,omitempty"`
+	// 消息的 key（默认 msg）
+	MessageKey *string `protobuf:"bytes,2,opt,name=message_key,json=messageKey,proto3,oneof" json:"message_key,omitempty"`
+	// 级别的 key（默认 level）
+	LevelKey *string `protobuf:"bytes,3,opt,name=level_key,json=levelKey,proto3,oneof" json:"level_key,omitempty"`
+	// 级别大小写（默认 upper）
+	LevelCase *string `protobuf:"bytes,4,opt,name=level_case,json=levelCase,proto3,oneof" json:"level_case,omitempty"`
+	// console 编码时级别是否带颜色（默认 true）
+	Color *bool `protobuf:"varint,5,opt,name=color,proto3,oneof" json:"color,omitempty"`
+}
+
+func (x *LogEncoder) Reset() {
+	*x = LogEncoder{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_pb_log_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LogEncoder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogEncoder) ProtoMessage() {}
+
+func (x *LogEncoder) ProtoReflect() protoreflect.Message {
+	mi := &file_config_pb_log_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogEncoder.ProtoReflect.Descriptor instead.
+func (*LogEncoder) Descriptor() ([]byte, []int) {
+	return file_config_pb_log_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *LogEncoder) GetTimeFormat() string {
+	if x != nil && x.TimeFormat != nil {
+		return *x.TimeFormat
+	}
+	return ""
+}
+
+func (x *LogEncoder) GetMessageKey() string {
+	if x != nil && x.MessageKey != nil {
+		return *x.MessageKey
+	}
+	return ""
+}
+
+func (x *LogEncoder) GetLevelKey() string {
+	if x != nil && x.LevelKey != nil {
+		return *x.LevelKey
+	}
+	return ""
+}
+
+func (x *LogEncoder) GetLevelCase() string {
+	if x != nil && x.LevelCase != nil {
+		return *x.LevelCase
+	}
+	return ""
+}
+
+func (x *LogEncoder) GetColor() bool {
+	if x != nil && x.Color != nil {
+		return *x.Color
+	}
+	return false
+}
+
+type LogAsync struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 是否禁用异步写入（默认禁用，同步写入）
+	Disable *bool `protobuf:"varint,1,opt,name=disable,proto3,oneof" json:"disable,omitempty"`
+	// 缓冲区大小，单位条（默认 4096）
+	BufferSize *int32 `protobuf:"varint,2,opt,name=buffer_size,json=bufferSize,proto3,oneof" json:"buffer_size,omitempty"`
+	// 缓冲区满时的处理策略（默认 drop_newest）
+	//   - block: 阻塞等待写入
+	//   - drop_oldest: 丢弃最早的日志
+	//   - drop_newest: 丢弃当前日志
+	Overflow *string `protobuf:"bytes,3,opt,name=overflow,proto3,oneof" json:"overflow,omitempty"`
+	// 缓冲区满时不丢弃 error 及以上级别的日志，改为阻塞等待（默认 true）
+	NeverDropErrors *bool `protobuf:"varint,4,opt,name=never_drop_errors,json=neverDropErrors,proto3,oneof" json:"never_drop_errors,omitempty"`
+	// 关闭时等待缓冲区写完的超时时间（默认 5s）
+	FlushTimeout *durationpb.Duration `protobuf:"bytes,5,opt,name=flush_timeout,json=flushTimeout,proto3,oneof" json:"flush_timeout,omitempty"`
+}
+
+func (x *LogAsync) Reset() {
+	*x = LogAsync{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_pb_log_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LogAsync) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogAsync) ProtoMessage() {}
+
+func (x *LogAsync) ProtoReflect() protoreflect.Message {
+	mi := &file_config_pb_log_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogAsync.ProtoReflect.Descriptor instead.
+func (*LogAsync) Descriptor() ([]byte, []int) {
+	return file_config_pb_log_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *LogAsync) GetDisable() bool {
+	if x != nil && x.Disable != nil {
+		return *x.Disable
+	}
+	return false
+}
+
+func (x *LogAsync) GetBufferSize() int32 {
+	if x != nil && x.BufferSize != nil {
+		return *x.BufferSize
+	}
+	return 0
+}
+
+func (x *LogAsync) GetOverflow() string {
+	if x != nil && x.Overflow != nil {
+		return *x.Overflow
+	}
+	return ""
+}
+
+func (x *LogAsync) GetNeverDropErrors() bool {
+	if x != nil && x.NeverDropErrors != nil {
+		return *x.NeverDropErrors
+	}
+	return false
+}
+
+func (x *LogAsync) GetFlushTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.FlushTimeout
+	}
+	return nil
+}
+
 type FileRotating struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -282,7 +877,7 @@ type FileRotating struct {
 	Disable *bool `protobuf:"varint,1,opt,name=disable,proto3,oneof" json:"disable,omitempty"`
 	// 日志文件在轮转前允许的最大大小。默认为 100 MB。
 	MaxSize *int64 `protobuf:"varint,2,opt,name=max_size,json=maxSize,proto3,oneof" json:"max_size,omitempty"`
-	// 是根据备份文件名中编码的时间戳来保留旧日志文件的最大天数。
+	// 是根据备份文件的修改时间来保留旧日志文件的最大天数。
 	// 注意：一天被定义为 24 小时，可能与日历中的自然日不完全对应，
 	// 比如受到夏令时、闰秒等影响。默认不会因为时间而删除旧日志文件。
 	// 默认会保留所有文件。
@@ -292,14 +887,23 @@ type FileRotating struct {
 	MaxFiles *int32 `protobuf:"varint,4,opt,name=max_files,json=maxFiles,proto3,oneof" json:"max_files,omitempty"`
 	// 决定备份文件名中的时间戳是否使用本地时间。默认使用 UTC 时间。
 	LocalTime *bool `protobuf:"varint,5,opt,name=local_time,json=localTime,proto3,oneof" json:"local_time,omitempty"`
-	// 决定轮转后的日志文件是否使用 gzip 压缩。默认不压缩。
+	// 决定轮转后的日志文件是否使用 gzip 压缩（在后台协程中执行）。默认不压缩。
 	Compress *bool `protobuf:"varint,6,opt,name=compress,proto3,oneof" json:"compress,omitempty"`
+	// 按时间轮转的周期（默认 none，只按大小轮转）
+	//   - none: 只按大小轮转
+	//   - hourly: 每小时轮转
+	//   - daily: 每天轮转
+	// 按时间轮转时同一周期内超过 max_size 仍会轮转，文件名追加 .1、.2 等序号
+	Interval *string `protobuf:"bytes,7,opt,name=interval,proto3,oneof" json:"interval,omitempty"`
+	// 轮转后的文件名，支持 %Y %m %d %H %M %S 时间占位符（时间为文件所属周期的开始时间），不能包含目录
+	// 默认为日志文件名加时间，例如 daily 时为 app-%Y-%m-%d.log，hourly 时为 app-%Y-%m-%d-%H.log
+	Pattern *string `protobuf:"bytes,8,opt,name=pattern,proto3,oneof" json:"pattern,omitempty"`
 }
 
 func (x *FileRotating) Reset() {
 	*x = FileRotating{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_pb_log_proto_msgTypes[3]
+		mi := &file_config_pb_log_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -312,7 +916,7 @@ func (x *FileRotating) String() string {
 func (*FileRotating) ProtoMessage() {}
 
 func (x *FileRotating) ProtoReflect() protoreflect.Message {
-	mi := &file_config_pb_log_proto_msgTypes[3]
+	mi := &file_config_pb_log_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -325,7 +929,7 @@ func (x *FileRotating) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FileRotating.ProtoReflect.Descriptor instead.
 func (*FileRotating) Descriptor() ([]byte, []int) {
-	return file_config_pb_log_proto_rawDescGZIP(), []int{3}
+	return file_config_pb_log_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *FileRotating) GetDisable() bool {
@@ -370,14 +974,33 @@ func (x *FileRotating) GetCompress() bool {
 	return false
 }
 
+func (x *FileRotating) GetInterval() string {
+	if x != nil && x.Interval != nil {
+		return *x.Interval
+	}
+	return ""
+}
+
+func (x *FileRotating) GetPattern() string {
+	if x != nil && x.Pattern != nil {
+		return *x.Pattern
+	}
+	return ""
+}
+
 var File_config_pb_log_proto protoreflect.FileDescriptor
 
 var file_config_pb_log_proto_rawDesc = []byte{
 	0x0a, 0x13, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x70, 0x62, 0x2f, 0x6c, 0x6f, 0x67, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x14, 0x6b, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x5f, 0x66, 0x6f,
-	0x75, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x62, 0x1a, 0x15, 0x70, 0x75, 0x62,
+	0x75, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x62, 0x1a, 0x15, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x5f, 0x70, 0x62, 0x2f, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x17, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x70, 0x62, 0x2f, 0x74, 0x72,
+	0x61, 0x63, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x15, 0x70, 0x75, 0x62,
 	0x67, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x22, 0xe4, 0x03, 0x0a, 0x03, 0x4c, 0x6f, 0x67, 0x12, 0x55, 0x0a, 0x05, 0x6c, 0x65,
+	0x74, 0x6f, 0x22, 0xd8, 0x06, 0x0a, 0x03, 0x4c, 0x6f, 0x67, 0x12, 0x55, 0x0a, 0x05, 0x6c, 0x65,
 	0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3a, 0xfa, 0xc4, 0x05, 0x36, 0x6a,
 	0x34, 0x2a, 0x05, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2a, 0x05, 0x44, 0x45, 0x42, 0x55, 0x47, 0x2a,
 	0x04, 0x69, 0x6e, 0x66, 0x6f, 0x2a, 0x04, 0x49, 0x4e, 0x46, 0x4f, 0x2a, 0x04, 0x77, 0x61, 0x72,
@@ -385,85 +1008,258 @@ var file_config_pb_log_proto_rawDesc = []byte{
 	0x45, 0x52, 0x52, 0x4f, 0x52, 0x48, 0x00, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x88, 0x01,
 	0x01, 0x12, 0x26, 0x0a, 0x0c, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x65, 0x6d, 0x70, 0x74,
 	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x48, 0x01, 0x52, 0x0b, 0x66, 0x69, 0x6c, 0x74, 0x65,
-	0x72, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x69, 0x6c,
-	0x74, 0x65, 0x72, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a,
-	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x24, 0x0a, 0x0b, 0x74, 0x69,
-	0x6d, 0x65, 0x5f, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48,
-	0x02, 0x52, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x88, 0x01, 0x01,
-	0x12, 0x36, 0x0a, 0x03, 0x73, 0x74, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
-	0x6b, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x5f, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x5f, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x64, 0x4c, 0x6f, 0x67, 0x67, 0x65, 0x72, 0x48, 0x03,
-	0x52, 0x03, 0x73, 0x74, 0x64, 0x88, 0x01, 0x01, 0x12, 0x39, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6b, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x5f,
-	0x66, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x62, 0x2e, 0x46, 0x69,
-	0x6c, 0x65, 0x4c, 0x6f, 0x67, 0x67, 0x65, 0x72, 0x48, 0x04, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65,
-	0x88, 0x01, 0x01, 0x12, 0x68, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x73, 0x65, 0x74, 0x18, 0x07, 0x20,
-	0x03, 0x28, 0x09, 0x42, 0x50, 0xfa, 0xc4, 0x05, 0x4c, 0x6a, 0x4a, 0x2a, 0x02, 0x74, 0x73, 0x2a,
-	0x0a, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x69, 0x64, 0x2a, 0x0c, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x2a, 0x0f, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x2e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x2a, 0x08, 0x74, 0x72, 0x61, 0x63,
-	0x65, 0x2e, 0x69, 0x64, 0x2a, 0x07, 0x73, 0x70, 0x61, 0x6e, 0x2e, 0x69, 0x64, 0x2a, 0x06, 0x63,
-	0x61, 0x6c, 0x6c, 0x65, 0x72, 0x52, 0x06, 0x70, 0x72, 0x65, 0x73, 0x65, 0x74, 0x42, 0x08, 0x0a,
-	0x06, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x66, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x5f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x74, 0x69, 0x6d,
-	0x65, 0x5f, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x73, 0x74, 0x64,
-	0x42, 0x07, 0x0a, 0x05, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x22, 0xb8, 0x01, 0x0a, 0x09, 0x53, 0x74,
-	0x64, 0x4c, 0x6f, 0x67, 0x67, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x07, 0x64, 0x69, 0x73, 0x61, 0x62,
-	0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x07, 0x64, 0x69, 0x73, 0x61,
-	0x62, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x12, 0x55, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3a, 0xfa, 0xc4, 0x05, 0x36, 0x6a, 0x34, 0x2a, 0x05, 0x64,
-	0x65, 0x62, 0x75, 0x67, 0x2a, 0x05, 0x44, 0x45, 0x42, 0x55, 0x47, 0x2a, 0x04, 0x69, 0x6e, 0x66,
-	0x6f, 0x2a, 0x04, 0x49, 0x4e, 0x46, 0x4f, 0x2a, 0x04, 0x77, 0x61, 0x72, 0x6e, 0x2a, 0x04, 0x57,
-	0x41, 0x52, 0x4e, 0x2a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x2a, 0x05, 0x45, 0x52, 0x52, 0x4f,
-	0x52, 0x48, 0x01, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a,
-	0x0b, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x03, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x0a, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x73, 0x42, 0x0a,
-	0x0a, 0x08, 0x5f, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6c,
-	0x65, 0x76, 0x65, 0x6c, 0x22, 0xad, 0x02, 0x0a, 0x0a, 0x46, 0x69, 0x6c, 0x65, 0x4c, 0x6f, 0x67,
-	0x67, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x07, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x07, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x88,
-	0x01, 0x01, 0x12, 0x55, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x3a, 0xfa, 0xc4, 0x05, 0x36, 0x6a, 0x34, 0x2a, 0x05, 0x64, 0x65, 0x62, 0x75, 0x67,
-	0x2a, 0x05, 0x44, 0x45, 0x42, 0x55, 0x47, 0x2a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x2a, 0x04, 0x49,
-	0x4e, 0x46, 0x4f, 0x2a, 0x04, 0x77, 0x61, 0x72, 0x6e, 0x2a, 0x04, 0x57, 0x41, 0x52, 0x4e, 0x2a,
-	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x2a, 0x05, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x48, 0x01, 0x52,
-	0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x69, 0x6c,
-	0x74, 0x65, 0x72, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a,
+	0x72, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x88, 0x01, 0x01, 0x12, 0x27, 0x0a, 0x0b, 0x66, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x42, 0x06,
+	0x8a, 0xb2, 0x19, 0x02, 0x08, 0x02, 0x52, 0x0a, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4b, 0x65,
+	0x79, 0x73, 0x12, 0x24, 0x0a, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x66, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x46,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x88, 0x01, 0x01, 0x12, 0x36, 0x0a, 0x03, 0x73, 0x74, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6b, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x5f, 0x66,
+	0x6f, 0x75, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x64,
+	0x4c, 0x6f, 0x67, 0x67, 0x65, 0x72, 0x48, 0x03, 0x52, 0x03, 0x73, 0x74, 0x64, 0x88, 0x01, 0x01,
+	0x12, 0x39, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20,
+	0x2e, 0x6b, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x5f, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4c, 0x6f, 0x67, 0x67, 0x65, 0x72,
+	0x48, 0x04, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x12, 0x68, 0x0a, 0x06, 0x70,
+	0x72, 0x65, 0x73, 0x65, 0x74, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x42, 0x50, 0xfa, 0xc4, 0x05,
+	0x4c, 0x6a, 0x4a, 0x2a, 0x02, 0x74, 0x73, 0x2a, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x69, 0x64, 0x2a, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x6e, 0x61, 0x6d,
+	0x65, 0x2a, 0x0f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x2a, 0x08, 0x74, 0x72, 0x61, 0x63, 0x65, 0x2e, 0x69, 0x64, 0x2a, 0x07, 0x73, 0x70,
+	0x61, 0x6e, 0x2e, 0x69, 0x64, 0x2a, 0x06, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x52, 0x06, 0x70,
+	0x72, 0x65, 0x73, 0x65, 0x74, 0x12, 0x42, 0x0a, 0x08, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x69, 0x6e,
+	0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6b, 0x72, 0x61, 0x74, 0x6f, 0x73,
+	0x5f, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x62, 0x2e, 0x4c,
+	0x6f, 0x67, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x69, 0x6e, 0x67, 0x48, 0x05, 0x52, 0x08, 0x73, 0x61,
+	0x6d, 0x70, 0x6c, 0x69, 0x6e, 0x67, 0x88, 0x01, 0x01, 0x12, 0x4e, 0x0a, 0x0c, 0x72, 0x65, 0x64,
+	0x61, 0x63, 0x74, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x23, 0x2e, 0x6b, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x5f, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x64, 0x61, 0x63, 0x74,
+	0x52, 0x75, 0x6c, 0x65, 0x42, 0x06, 0x8a, 0xb2, 0x19, 0x02, 0x08, 0x02, 0x52, 0x0b, 0x72, 0x65,
+	0x64, 0x61, 0x63, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x04, 0x6f, 0x74, 0x6c,
+	0x70, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6b, 0x72, 0x61, 0x74, 0x6f, 0x73,
+	0x5f, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x62, 0x2e, 0x4f,
+	0x74, 0x6c, 0x70, 0x4c, 0x6f, 0x67, 0x67, 0x65, 0x72, 0x48, 0x06, 0x52, 0x04, 0x6f, 0x74, 0x6c,
+	0x70, 0x88, 0x01, 0x01, 0x12, 0x44, 0x0a, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x0b, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6b, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x5f, 0x66, 0x6f, 0x75,
+	0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4c,
+	0x6f, 0x67, 0x67, 0x65, 0x72, 0x42, 0x0c, 0x8a, 0xb2, 0x19, 0x08, 0x08, 0x03, 0x12, 0x04, 0x70,
+	0x61, 0x74, 0x68, 0x52, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x0f, 0x72, 0x65,
+	0x64, 0x61, 0x63, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x07, 0x52, 0x0d, 0x72, 0x65, 0x64, 0x61, 0x63, 0x74, 0x48, 0x61, 0x73,
+	0x68, 0x4b, 0x65, 0x79, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6c, 0x65, 0x76, 0x65,
+	0x6c, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x65, 0x6d, 0x70,
+	0x74, 0x79, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x66, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x73, 0x74, 0x64, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x66,
+	0x69, 0x6c, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x69, 0x6e, 0x67,
+	0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6f, 0x74, 0x6c, 0x70, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x72, 0x65,
+	0x64, 0x61, 0x63, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x22, 0x7a, 0x0a,
+	0x0d, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x64, 0x61, 0x63, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x65,
+	0x79, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x34, 0x0a, 0x04, 0x6d, 0x61,
+	0x73, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1b, 0xfa, 0xc4, 0x05, 0x17, 0x6a, 0x15,
+	0x2a, 0x04, 0x66, 0x75, 0x6c, 0x6c, 0x2a, 0x07, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x2a,
+	0x04, 0x68, 0x61, 0x73, 0x68, 0x48, 0x00, 0x52, 0x04, 0x6d, 0x61, 0x73, 0x6b, 0x88, 0x01, 0x01,
+	0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x22, 0xce, 0x02, 0x0a, 0x0b, 0x4c, 0x6f,
+	0x67, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x69, 0x6e, 0x67, 0x12, 0x1d, 0x0a, 0x07, 0x64, 0x69, 0x73,
+	0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x07, 0x64, 0x69,
+	0x73, 0x61, 0x62, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x12, 0x3a, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x01, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
+	0x6c, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x66, 0x69, 0x72, 0x73, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x48, 0x02, 0x52, 0x05, 0x66, 0x69, 0x72, 0x73, 0x74, 0x88, 0x01, 0x01, 0x12,
+	0x23, 0x0a, 0x0a, 0x74, 0x68, 0x65, 0x72, 0x65, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x48, 0x03, 0x52, 0x0a, 0x74, 0x68, 0x65, 0x72, 0x65, 0x61, 0x66, 0x74, 0x65,
+	0x72, 0x88, 0x01, 0x01, 0x12, 0x49, 0x0a, 0x10, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x5f,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x04, 0x52, 0x0f, 0x73, 0x75, 0x6d,
+	0x6d, 0x61, 0x72, 0x79, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x88, 0x01, 0x01, 0x12,
+	0x12, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6b,
+	0x65, 0x79, 0x73, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x42,
+	0x0b, 0x0a, 0x09, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x42, 0x08, 0x0a, 0x06,
+	0x5f, 0x66, 0x69, 0x72, 0x73, 0x74, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x74, 0x68, 0x65, 0x72, 0x65,
+	0x61, 0x66, 0x74, 0x65, 0x72, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72,
+	0x79, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x22, 0x9f, 0x03, 0x0a, 0x09, 0x53,
+	0x74, 0x64, 0x4c, 0x6f, 0x67, 0x67, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x07, 0x64, 0x69, 0x73, 0x61,
+	0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x07, 0x64, 0x69, 0x73,
+	0x61, 0x62, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x12, 0x55, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3a, 0xfa, 0xc4, 0x05, 0x36, 0x6a, 0x34, 0x2a, 0x05,
+	0x64, 0x65, 0x62, 0x75, 0x67, 0x2a, 0x05, 0x44, 0x45, 0x42, 0x55, 0x47, 0x2a, 0x04, 0x69, 0x6e,
+	0x66, 0x6f, 0x2a, 0x04, 0x49, 0x4e, 0x46, 0x4f, 0x2a, 0x04, 0x77, 0x61, 0x72, 0x6e, 0x2a, 0x04,
+	0x57, 0x41, 0x52, 0x4e, 0x2a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x2a, 0x05, 0x45, 0x52, 0x52,
+	0x4f, 0x52, 0x48, 0x01, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x27,
+	0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x09, 0x42, 0x06, 0x8a, 0xb2, 0x19, 0x02, 0x08, 0x02, 0x52, 0x0a, 0x66, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x39, 0x0a, 0x05, 0x61, 0x73, 0x79, 0x6e, 0x63,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6b, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x5f,
+	0x66, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x62, 0x2e, 0x4c, 0x6f,
+	0x67, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x48, 0x02, 0x52, 0x05, 0x61, 0x73, 0x79, 0x6e, 0x63, 0x88,
+	0x01, 0x01, 0x12, 0x3e, 0x0a, 0x08, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0xfa, 0xc4, 0x05, 0x19, 0x6a, 0x17, 0x2a, 0x04, 0x6a, 0x73,
+	0x6f, 0x6e, 0x2a, 0x06, 0x6c, 0x6f, 0x67, 0x66, 0x6d, 0x74, 0x2a, 0x07, 0x63, 0x6f, 0x6e, 0x73,
+	0x6f, 0x6c, 0x65, 0x48, 0x03, 0x52, 0x08, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x88,
+	0x01, 0x01, 0x12, 0x3f, 0x0a, 0x07, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6b, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x5f, 0x66, 0x6f, 0x75,
+	0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x67, 0x45, 0x6e,
+	0x63, 0x6f, 0x64, 0x65, 0x72, 0x48, 0x04, 0x52, 0x07, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72,
+	0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x42,
+	0x08, 0x0a, 0x06, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x61, 0x73,
+	0x79, 0x6e, 0x63, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67,
+	0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x22, 0x8e, 0x05, 0x0a,
+	0x0a, 0x46, 0x69, 0x6c, 0x65, 0x4c, 0x6f, 0x67, 0x67, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x07, 0x64,
+	0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x07,
+	0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x12, 0x55, 0x0a, 0x05, 0x6c, 0x65,
+	0x76, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3a, 0xfa, 0xc4, 0x05, 0x36, 0x6a,
+	0x34, 0x2a, 0x05, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2a, 0x05, 0x44, 0x45, 0x42, 0x55, 0x47, 0x2a,
+	0x04, 0x69, 0x6e, 0x66, 0x6f, 0x2a, 0x04, 0x49, 0x4e, 0x46, 0x4f, 0x2a, 0x04, 0x77, 0x61, 0x72,
+	0x6e, 0x2a, 0x04, 0x57, 0x41, 0x52, 0x4e, 0x2a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x2a, 0x05,
+	0x45, 0x52, 0x52, 0x4f, 0x52, 0x48, 0x01, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x88, 0x01,
+	0x01, 0x12, 0x27, 0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x6b, 0x65, 0x79, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x42, 0x06, 0x8a, 0xb2, 0x19, 0x02, 0x08, 0x02, 0x52, 0x0a,
 	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x17, 0x0a, 0x04, 0x70, 0x61,
 	0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68,
 	0x88, 0x01, 0x01, 0x12, 0x43, 0x0a, 0x08, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18,
 	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6b, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x5f, 0x66,
 	0x6f, 0x75, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6c,
 	0x65, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x48, 0x03, 0x52, 0x08, 0x72, 0x6f, 0x74,
-	0x61, 0x74, 0x69, 0x6e, 0x67, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x64, 0x69, 0x73,
-	0x61, 0x62, 0x6c, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x42, 0x07,
-	0x0a, 0x05, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x72, 0x6f, 0x74, 0x61,
-	0x74, 0x69, 0x6e, 0x67, 0x22, 0xaf, 0x02, 0x0a, 0x0c, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x6f, 0x74,
-	0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x1d, 0x0a, 0x07, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x07, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c,
-	0x65, 0x88, 0x01, 0x01, 0x12, 0x1e, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x69, 0x7a, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x53, 0x69, 0x7a,
-	0x65, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x66, 0x69, 0x6c, 0x65,
-	0x5f, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x48, 0x02, 0x52, 0x0a, 0x6d, 0x61,
-	0x78, 0x46, 0x69, 0x6c, 0x65, 0x41, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x09, 0x6d,
-	0x61, 0x78, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x48, 0x03,
-	0x52, 0x08, 0x6d, 0x61, 0x78, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a,
-	0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x08, 0x48, 0x04, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x88, 0x01,
-	0x01, 0x12, 0x1f, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x08, 0x48, 0x05, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x88,
-	0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x0b,
-	0x0a, 0x09, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x0f, 0x0a, 0x0d, 0x5f,
-	0x6d, 0x61, 0x78, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x61, 0x67, 0x65, 0x42, 0x0c, 0x0a, 0x0a,
-	0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6c,
-	0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x63, 0x6f,
-	0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x42, 0x54, 0x5a, 0x52, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6a, 0x61, 0x67, 0x67, 0x65, 0x72, 0x7a, 0x68, 0x75, 0x61, 0x6e,
-	0x67, 0x31, 0x39, 0x39, 0x34, 0x2f, 0x6b, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2d, 0x66, 0x6f, 0x75,
-	0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6b, 0x72,
+	0x61, 0x74, 0x69, 0x6e, 0x67, 0x88, 0x01, 0x01, 0x12, 0x39, 0x0a, 0x05, 0x61, 0x73, 0x79, 0x6e,
+	0x63, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6b, 0x72, 0x61, 0x74, 0x6f, 0x73,
+	0x5f, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x62, 0x2e, 0x4c,
+	0x6f, 0x67, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x48, 0x04, 0x52, 0x05, 0x61, 0x73, 0x79, 0x6e, 0x63,
+	0x88, 0x01, 0x01, 0x12, 0x3e, 0x0a, 0x08, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0xfa, 0xc4, 0x05, 0x19, 0x6a, 0x17, 0x2a, 0x04, 0x6a,
+	0x73, 0x6f, 0x6e, 0x2a, 0x06, 0x6c, 0x6f, 0x67, 0x66, 0x6d, 0x74, 0x2a, 0x07, 0x63, 0x6f, 0x6e,
+	0x73, 0x6f, 0x6c, 0x65, 0x48, 0x05, 0x52, 0x08, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67,
+	0x88, 0x01, 0x01, 0x12, 0x3f, 0x0a, 0x07, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6b, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x5f, 0x66, 0x6f,
+	0x75, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x67, 0x45,
+	0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x48, 0x06, 0x52, 0x07, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65,
+	0x72, 0x88, 0x01, 0x01, 0x12, 0x6a, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x65, 0x76, 0x65,
+	0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x42, 0x48, 0xfa, 0xc4, 0x05, 0x44, 0x6a, 0x42, 0x2a,
+	0x05, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2a, 0x05, 0x44, 0x45, 0x42, 0x55, 0x47, 0x2a, 0x04, 0x69,
+	0x6e, 0x66, 0x6f, 0x2a, 0x04, 0x49, 0x4e, 0x46, 0x4f, 0x2a, 0x04, 0x77, 0x61, 0x72, 0x6e, 0x2a,
+	0x04, 0x57, 0x41, 0x52, 0x4e, 0x2a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x2a, 0x05, 0x45, 0x52,
+	0x52, 0x4f, 0x52, 0x2a, 0x05, 0x66, 0x61, 0x74, 0x61, 0x6c, 0x2a, 0x05, 0x46, 0x41, 0x54, 0x41,
+	0x4c, 0x48, 0x07, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x88, 0x01, 0x01,
+	0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x08, 0x0a, 0x06,
+	0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x42,
+	0x0b, 0x0a, 0x09, 0x5f, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x42, 0x08, 0x0a, 0x06,
+	0x5f, 0x61, 0x73, 0x79, 0x6e, 0x63, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x65, 0x6e, 0x63, 0x6f, 0x64,
+	0x69, 0x6e, 0x67, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x42,
+	0x0c, 0x0a, 0x0a, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0xd8, 0x02,
+	0x0a, 0x0a, 0x4f, 0x74, 0x6c, 0x70, 0x4c, 0x6f, 0x67, 0x67, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x07,
+	0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52,
+	0x07, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x12, 0x55, 0x0a, 0x05, 0x6c,
+	0x65, 0x76, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3a, 0xfa, 0xc4, 0x05, 0x36,
+	0x6a, 0x34, 0x2a, 0x05, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2a, 0x05, 0x44, 0x45, 0x42, 0x55, 0x47,
+	0x2a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x2a, 0x04, 0x49, 0x4e, 0x46, 0x4f, 0x2a, 0x04, 0x77, 0x61,
+	0x72, 0x6e, 0x2a, 0x04, 0x57, 0x41, 0x52, 0x4e, 0x2a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x2a,
+	0x05, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x48, 0x01, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x88,
+	0x01, 0x01, 0x12, 0x27, 0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x6b, 0x65, 0x79,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x42, 0x06, 0x8a, 0xb2, 0x19, 0x02, 0x08, 0x02, 0x52,
+	0x0a, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x3f, 0x0a, 0x08, 0x65,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
+	0x6b, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x5f, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x48, 0x02, 0x52,
+	0x08, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x3d, 0x0a, 0x05,
+	0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6b, 0x72,
 	0x61, 0x74, 0x6f, 0x73, 0x5f, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x70, 0x62, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x70, 0x62, 0x2e, 0x4f, 0x74, 0x6c, 0x70, 0x4c, 0x6f, 0x67, 0x42, 0x61, 0x74, 0x63, 0x68, 0x48,
+	0x03, 0x52, 0x05, 0x62, 0x61, 0x74, 0x63, 0x68, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f,
+	0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6c, 0x65, 0x76, 0x65,
+	0x6c, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x42, 0x08,
+	0x0a, 0x06, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x22, 0xfb, 0x01, 0x0a, 0x0c, 0x4f, 0x74, 0x6c,
+	0x70, 0x4c, 0x6f, 0x67, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x29, 0x0a, 0x0e, 0x6d, 0x61, 0x78,
+	0x5f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x48, 0x00, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x51, 0x75, 0x65, 0x75, 0x65, 0x53, 0x69, 0x7a,
+	0x65, 0x88, 0x01, 0x01, 0x12, 0x47, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x01, 0x52, 0x0e, 0x65, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x36, 0x0a,
+	0x15, 0x6d, 0x61, 0x78, 0x5f, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x62, 0x61, 0x74, 0x63,
+	0x68, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x48, 0x02, 0x52, 0x12,
+	0x6d, 0x61, 0x78, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x69,
+	0x7a, 0x65, 0x88, 0x01, 0x01, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x71, 0x75,
+	0x65, 0x75, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x65, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x42, 0x18, 0x0a, 0x16,
+	0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x62, 0x61, 0x74, 0x63,
+	0x68, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x96, 0x02, 0x0a, 0x0a, 0x4c, 0x6f, 0x67, 0x45, 0x6e,
+	0x63, 0x6f, 0x64, 0x65, 0x72, 0x12, 0x24, 0x0a, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x74, 0x69,
+	0x6d, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x0b, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x01, 0x52, 0x0a, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4b, 0x65, 0x79, 0x88, 0x01,
+	0x01, 0x12, 0x20, 0x0a, 0x09, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x08, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x4b, 0x65, 0x79,
+	0x88, 0x01, 0x01, 0x12, 0x38, 0x0a, 0x0a, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x5f, 0x63, 0x61, 0x73,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0xfa, 0xc4, 0x05, 0x10, 0x6a, 0x0e, 0x2a,
+	0x05, 0x75, 0x70, 0x70, 0x65, 0x72, 0x2a, 0x05, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x48, 0x03, 0x52,
+	0x09, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x43, 0x61, 0x73, 0x65, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a,
+	0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x48, 0x04, 0x52, 0x05,
+	0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x5f, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6c, 0x65, 0x76,
+	0x65, 0x6c, 0x5f, 0x6b, 0x65, 0x79, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c,
+	0x5f, 0x63, 0x61, 0x73, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x22,
+	0xe0, 0x02, 0x0a, 0x08, 0x4c, 0x6f, 0x67, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x12, 0x1d, 0x0a, 0x07,
+	0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52,
+	0x07, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x0b, 0x62,
+	0x75, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x48, 0x01, 0x52, 0x0a, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x53, 0x69, 0x7a, 0x65, 0x88, 0x01,
+	0x01, 0x12, 0x48, 0x0a, 0x08, 0x6f, 0x76, 0x65, 0x72, 0x66, 0x6c, 0x6f, 0x77, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x27, 0xfa, 0xc4, 0x05, 0x23, 0x6a, 0x21, 0x2a, 0x05, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x2a, 0x0b, 0x64, 0x72, 0x6f, 0x70, 0x5f, 0x6f, 0x6c, 0x64, 0x65, 0x73, 0x74, 0x2a,
+	0x0b, 0x64, 0x72, 0x6f, 0x70, 0x5f, 0x6e, 0x65, 0x77, 0x65, 0x73, 0x74, 0x48, 0x02, 0x52, 0x08,
+	0x6f, 0x76, 0x65, 0x72, 0x66, 0x6c, 0x6f, 0x77, 0x88, 0x01, 0x01, 0x12, 0x2f, 0x0a, 0x11, 0x6e,
+	0x65, 0x76, 0x65, 0x72, 0x5f, 0x64, 0x72, 0x6f, 0x70, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x48, 0x03, 0x52, 0x0f, 0x6e, 0x65, 0x76, 0x65, 0x72, 0x44,
+	0x72, 0x6f, 0x70, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x88, 0x01, 0x01, 0x12, 0x43, 0x0a, 0x0d,
+	0x66, 0x6c, 0x75, 0x73, 0x68, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x04,
+	0x52, 0x0c, 0x66, 0x6c, 0x75, 0x73, 0x68, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x88, 0x01,
+	0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x0e, 0x0a,
+	0x0c, 0x5f, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x0b, 0x0a,
+	0x09, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x66, 0x6c, 0x6f, 0x77, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x6e,
+	0x65, 0x76, 0x65, 0x72, 0x5f, 0x64, 0x72, 0x6f, 0x70, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73,
+	0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x66, 0x6c, 0x75, 0x73, 0x68, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x22, 0xa5, 0x03, 0x0a, 0x0c, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x6f, 0x74, 0x61, 0x74,
+	0x69, 0x6e, 0x67, 0x12, 0x1d, 0x0a, 0x07, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x07, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x88,
+	0x01, 0x01, 0x12, 0x1e, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x53, 0x69, 0x7a, 0x65, 0x88,
+	0x01, 0x01, 0x12, 0x25, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x61,
+	0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x48, 0x02, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x46,
+	0x69, 0x6c, 0x65, 0x41, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x09, 0x6d, 0x61, 0x78,
+	0x5f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x48, 0x03, 0x52, 0x08,
+	0x6d, 0x61, 0x78, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x6c,
+	0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x48,
+	0x04, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12,
+	0x1f, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x08, 0x48, 0x05, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x88, 0x01, 0x01,
+	0x12, 0x3c, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x1b, 0xfa, 0xc4, 0x05, 0x17, 0x6a, 0x15, 0x2a, 0x04, 0x6e, 0x6f, 0x6e, 0x65,
+	0x2a, 0x06, 0x68, 0x6f, 0x75, 0x72, 0x6c, 0x79, 0x2a, 0x05, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x48,
+	0x06, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x1d,
+	0x0a, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x07, 0x52, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a,
+	0x08, 0x5f, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6d, 0x61,
+	0x78, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x66,
+	0x69, 0x6c, 0x65, 0x5f, 0x61, 0x67, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6d, 0x61, 0x78, 0x5f,
+	0x66, 0x69, 0x6c, 0x65, 0x73, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73,
+	0x73, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x42, 0x0a,
+	0x0a, 0x08, 0x5f, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x42, 0x54, 0x5a, 0x52, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6a, 0x61, 0x67, 0x67, 0x65, 0x72, 0x7a,
+	0x68, 0x75, 0x61, 0x6e, 0x67, 0x31, 0x39, 0x39, 0x34, 0x2f, 0x6b, 0x72, 0x61, 0x74, 0x6f, 0x73,
+	0x2d, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2f, 0x6b, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x5f, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x62, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x70, 0x62,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -478,22 +1274,44 @@ func file_config_pb_log_proto_rawDescGZIP() []byte {
 	return file_config_pb_log_proto_rawDescData
 }
 
-var file_config_pb_log_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_config_pb_log_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_config_pb_log_proto_goTypes = []interface{}{
-	(*Log)(nil),          // 0: kratos_foundation_pb.Log
-	(*StdLogger)(nil),    // 1: kratos_foundation_pb.StdLogger
-	(*FileLogger)(nil),   // 2: kratos_foundation_pb.FileLogger
-	(*FileRotating)(nil), // 3: kratos_foundation_pb.FileRotating
+	(*Log)(nil),                 // 0: kratos_foundation_pb.Log
+	(*LogRedactRule)(nil),       // 1: kratos_foundation_pb.LogRedactRule
+	(*LogSampling)(nil),         // 2: kratos_foundation_pb.LogSampling
+	(*StdLogger)(nil),           // 3: kratos_foundation_pb.StdLogger
+	(*FileLogger)(nil),          // 4: kratos_foundation_pb.FileLogger
+	(*OtlpLogger)(nil),          // 5: kratos_foundation_pb.OtlpLogger
+	(*OtlpLogBatch)(nil),        // 6: kratos_foundation_pb.OtlpLogBatch
+	(*LogEncoder)(nil),          // 7: kratos_foundation_pb.LogEncoder
+	(*LogAsync)(nil),            // 8: kratos_foundation_pb.LogAsync
+	(*FileRotating)(nil),        // 9: kratos_foundation_pb.FileRotating
+	(*durationpb.Duration)(nil), // 10: google.protobuf.Duration
+	(*Exporter)(nil),            // 11: kratos_foundation_pb.Exporter
 }
 var file_config_pb_log_proto_depIdxs = []int32{
-	1, // 0: kratos_foundation_pb.Log.std:type_name -> kratos_foundation_pb.StdLogger
-	2, // 1: kratos_foundation_pb.Log.file:type_name -> kratos_foundation_pb.FileLogger
-	3, // 2: kratos_foundation_pb.FileLogger.rotating:type_name -> kratos_foundation_pb.FileRotating
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	3,  // 0: kratos_foundation_pb.Log.std:type_name -> kratos_foundation_pb.StdLogger
+	4,  // 1: kratos_foundation_pb.Log.file:type_name -> kratos_foundation_pb.FileLogger
+	2,  // 2: kratos_foundation_pb.Log.sampling:type_name -> kratos_foundation_pb.LogSampling
+	1,  // 3: kratos_foundation_pb.Log.redact_rules:type_name -> kratos_foundation_pb.LogRedactRule
+	5,  // 4: kratos_foundation_pb.Log.otlp:type_name -> kratos_foundation_pb.OtlpLogger
+	4,  // 5: kratos_foundation_pb.Log.files:type_name -> kratos_foundation_pb.FileLogger
+	10, // 6: kratos_foundation_pb.LogSampling.interval:type_name -> google.protobuf.Duration
+	10, // 7: kratos_foundation_pb.LogSampling.summary_interval:type_name -> google.protobuf.Duration
+	8,  // 8: kratos_foundation_pb.StdLogger.async:type_name -> kratos_foundation_pb.LogAsync
+	7,  // 9: kratos_foundation_pb.StdLogger.encoder:type_name -> kratos_foundation_pb.LogEncoder
+	9,  // 10: kratos_foundation_pb.FileLogger.rotating:type_name -> kratos_foundation_pb.FileRotating
+	8,  // 11: kratos_foundation_pb.FileLogger.async:type_name -> kratos_foundation_pb.LogAsync
+	7,  // 12: kratos_foundation_pb.FileLogger.encoder:type_name -> kratos_foundation_pb.LogEncoder
+	11, // 13: kratos_foundation_pb.OtlpLogger.exporter:type_name -> kratos_foundation_pb.Exporter
+	6,  // 14: kratos_foundation_pb.OtlpLogger.batch:type_name -> kratos_foundation_pb.OtlpLogBatch
+	10, // 15: kratos_foundation_pb.OtlpLogBatch.export_interval:type_name -> google.protobuf.Duration
+	10, // 16: kratos_foundation_pb.LogAsync.flush_timeout:type_name -> google.protobuf.Duration
+	17, // [17:17] is the sub-list for method output_type
+	17, // [17:17] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
 }
 
 func init() { file_config_pb_log_proto_init() }
@@ -501,6 +1319,8 @@ func file_config_pb_log_proto_init() {
 	if File_config_pb_log_proto != nil {
 		return
 	}
+	file_config_pb_merge_proto_init()
+	file_config_pb_tracing_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_config_pb_log_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Log); i {
@@ -515,7 +1335,7 @@ func file_config_pb_log_proto_init() {
 			}
 		}
 		file_config_pb_log_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StdLogger); i {
+			switch v := v.(*LogRedactRule); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -527,7 +1347,7 @@ func file_config_pb_log_proto_init() {
 			}
 		}
 		file_config_pb_log_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FileLogger); i {
+			switch v := v.(*LogSampling); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -539,6 +1359,78 @@ func file_config_pb_log_proto_init() {
 			}
 		}
 		file_config_pb_log_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StdLogger); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_pb_log_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FileLogger); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_pb_log_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OtlpLogger); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_pb_log_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OtlpLogBatch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_pb_log_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogEncoder); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_pb_log_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogAsync); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_pb_log_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FileRotating); i {
 			case 0:
 				return &v.state
@@ -555,13 +1447,19 @@ func file_config_pb_log_proto_init() {
 	file_config_pb_log_proto_msgTypes[1].OneofWrappers = []interface{}{}
 	file_config_pb_log_proto_msgTypes[2].OneofWrappers = []interface{}{}
 	file_config_pb_log_proto_msgTypes[3].OneofWrappers = []interface{}{}
+	file_config_pb_log_proto_msgTypes[4].OneofWrappers = []interface{}{}
+	file_config_pb_log_proto_msgTypes[5].OneofWrappers = []interface{}{}
+	file_config_pb_log_proto_msgTypes[6].OneofWrappers = []interface{}{}
+	file_config_pb_log_proto_msgTypes[7].OneofWrappers = []interface{}{}
+	file_config_pb_log_proto_msgTypes[8].OneofWrappers = []interface{}{}
+	file_config_pb_log_proto_msgTypes[9].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_config_pb_log_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -56,6 +56,74 @@ func (m *Log) validate(all bool) error {
 
 	var errors []error
 
+	for idx, item := range m.GetRedactRules() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, LogValidationError{
+						field:  fmt.Sprintf("RedactRules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, LogValidationError{
+						field:  fmt.Sprintf("RedactRules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return LogValidationError{
+					field:  fmt.Sprintf("RedactRules[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetFiles() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, LogValidationError{
+						field:  fmt.Sprintf("Files[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, LogValidationError{
+						field:  fmt.Sprintf("Files[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return LogValidationError{
+					field:  fmt.Sprintf("Files[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if m.Level != nil {
 		// no validation rules for Level
 	}
@@ -134,6 +202,76 @@ func (m *Log) validate(all bool) error {
 
 	}
 
+	if m.Sampling != nil {
+
+		if all {
+			switch v := interface{}(m.GetSampling()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, LogValidationError{
+						field:  "Sampling",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, LogValidationError{
+						field:  "Sampling",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetSampling()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return LogValidationError{
+					field:  "Sampling",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.Otlp != nil {
+
+		if all {
+			switch v := interface{}(m.GetOtlp()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, LogValidationError{
+						field:  "Otlp",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, LogValidationError{
+						field:  "Otlp",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetOtlp()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return LogValidationError{
+					field:  "Otlp",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.RedactHashKey != nil {
+		// no validation rules for RedactHashKey
+	}
+
 	if len(errors) > 0 {
 		return LogMultiError(errors)
 	}
@@ -211,49 +349,46 @@ var _ interface {
 	ErrorName() string
 } = LogValidationError{}
 
-// Validate checks the field values on StdLogger with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
+// Validate checks the field values on LogRedactRule with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *StdLogger) Validate() error {
+func (m *LogRedactRule) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on StdLogger with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in StdLoggerMultiError, or nil
-// if none found.
-func (m *StdLogger) ValidateAll() error {
+// ValidateAll checks the field values on LogRedactRule with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in LogRedactRuleMultiError, or
+// nil if none found.
+func (m *LogRedactRule) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *StdLogger) validate(all bool) error {
+func (m *LogRedactRule) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.Disable != nil {
-		// no validation rules for Disable
-	}
-
-	if m.Level != nil {
-		// no validation rules for Level
+	if m.Mask != nil {
+		// no validation rules for Mask
 	}
 
 	if len(errors) > 0 {
-		return StdLoggerMultiError(errors)
+		return LogRedactRuleMultiError(errors)
 	}
 
 	return nil
 }
 
-// StdLoggerMultiError is an error wrapping multiple validation errors returned
-// by StdLogger.ValidateAll() if the designated constraints aren't met.
-type StdLoggerMultiError []error
+// LogRedactRuleMultiError is an error wrapping multiple validation errors
+// returned by LogRedactRule.ValidateAll() if the designated constraints
+// aren't met.
+type LogRedactRuleMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m StdLoggerMultiError) Error() string {
+func (m LogRedactRuleMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -262,11 +397,11 @@ func (m StdLoggerMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m StdLoggerMultiError) AllErrors() []error { return m }
+func (m LogRedactRuleMultiError) AllErrors() []error { return m }
 
-// StdLoggerValidationError is the validation error returned by
-// StdLogger.Validate if the designated constraints aren't met.
-type StdLoggerValidationError struct {
+// LogRedactRuleValidationError is the validation error returned by
+// LogRedactRule.Validate if the designated constraints aren't met.
+type LogRedactRuleValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -274,22 +409,22 @@ type StdLoggerValidationError struct {
 }
 
 // Field function returns field value.
-func (e StdLoggerValidationError) Field() string { return e.field }
+func (e LogRedactRuleValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e StdLoggerValidationError) Reason() string { return e.reason }
+func (e LogRedactRuleValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e StdLoggerValidationError) Cause() error { return e.cause }
+func (e LogRedactRuleValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e StdLoggerValidationError) Key() bool { return e.key }
+func (e LogRedactRuleValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e StdLoggerValidationError) ErrorName() string { return "StdLoggerValidationError" }
+func (e LogRedactRuleValidationError) ErrorName() string { return "LogRedactRuleValidationError" }
 
 // Error satisfies the builtin error interface
-func (e StdLoggerValidationError) Error() string {
+func (e LogRedactRuleValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -301,14 +436,14 @@ func (e StdLoggerValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sStdLogger.%s: %s%s",
+		"invalid %sLogRedactRule.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = StdLoggerValidationError{}
+var _ error = LogRedactRuleValidationError{}
 
 var _ interface {
 	Field() string
@@ -316,24 +451,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = StdLoggerValidationError{}
+} = LogRedactRuleValidationError{}
 
-// Validate checks the field values on FileLogger with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
+// Validate checks the field values on LogSampling with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *FileLogger) Validate() error {
+func (m *LogSampling) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on FileLogger with the rules defined in
+// ValidateAll checks the field values on LogSampling with the rules defined in
 // the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in FileLoggerMultiError, or
+// result is a list of violation errors wrapped in LogSamplingMultiError, or
 // nil if none found.
-func (m *FileLogger) ValidateAll() error {
+func (m *LogSampling) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *FileLogger) validate(all bool) error {
+func (m *LogSampling) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -344,39 +479,72 @@ func (m *FileLogger) validate(all bool) error {
 		// no validation rules for Disable
 	}
 
-	if m.Level != nil {
-		// no validation rules for Level
+	if m.Interval != nil {
+
+		if all {
+			switch v := interface{}(m.GetInterval()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, LogSamplingValidationError{
+						field:  "Interval",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, LogSamplingValidationError{
+						field:  "Interval",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetInterval()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return LogSamplingValidationError{
+					field:  "Interval",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
 	}
 
-	if m.Path != nil {
-		// no validation rules for Path
+	if m.First != nil {
+		// no validation rules for First
 	}
 
-	if m.Rotating != nil {
+	if m.Thereafter != nil {
+		// no validation rules for Thereafter
+	}
+
+	if m.SummaryInterval != nil {
 
 		if all {
-			switch v := interface{}(m.GetRotating()).(type) {
+			switch v := interface{}(m.GetSummaryInterval()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, FileLoggerValidationError{
-						field:  "Rotating",
+					errors = append(errors, LogSamplingValidationError{
+						field:  "SummaryInterval",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, FileLoggerValidationError{
-						field:  "Rotating",
+					errors = append(errors, LogSamplingValidationError{
+						field:  "SummaryInterval",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetRotating()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetSummaryInterval()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return FileLoggerValidationError{
-					field:  "Rotating",
+				return LogSamplingValidationError{
+					field:  "SummaryInterval",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -386,18 +554,18 @@ func (m *FileLogger) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return FileLoggerMultiError(errors)
+		return LogSamplingMultiError(errors)
 	}
 
 	return nil
 }
 
-// FileLoggerMultiError is an error wrapping multiple validation errors
-// returned by FileLogger.ValidateAll() if the designated constraints aren't met.
-type FileLoggerMultiError []error
+// LogSamplingMultiError is an error wrapping multiple validation errors
+// returned by LogSampling.ValidateAll() if the designated constraints aren't met.
+type LogSamplingMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m FileLoggerMultiError) Error() string {
+func (m LogSamplingMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -406,11 +574,11 @@ func (m FileLoggerMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m FileLoggerMultiError) AllErrors() []error { return m }
+func (m LogSamplingMultiError) AllErrors() []error { return m }
 
-// FileLoggerValidationError is the validation error returned by
-// FileLogger.Validate if the designated constraints aren't met.
-type FileLoggerValidationError struct {
+// LogSamplingValidationError is the validation error returned by
+// LogSampling.Validate if the designated constraints aren't met.
+type LogSamplingValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -418,22 +586,22 @@ type FileLoggerValidationError struct {
 }
 
 // Field function returns field value.
-func (e FileLoggerValidationError) Field() string { return e.field }
+func (e LogSamplingValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e FileLoggerValidationError) Reason() string { return e.reason }
+func (e LogSamplingValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e FileLoggerValidationError) Cause() error { return e.cause }
+func (e LogSamplingValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e FileLoggerValidationError) Key() bool { return e.key }
+func (e LogSamplingValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e FileLoggerValidationError) ErrorName() string { return "FileLoggerValidationError" }
+func (e LogSamplingValidationError) ErrorName() string { return "LogSamplingValidationError" }
 
 // Error satisfies the builtin error interface
-func (e FileLoggerValidationError) Error() string {
+func (e LogSamplingValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -445,14 +613,14 @@ func (e FileLoggerValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sFileLogger.%s: %s%s",
+		"invalid %sLogSampling.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = FileLoggerValidationError{}
+var _ error = LogSamplingValidationError{}
 
 var _ interface {
 	Field() string
@@ -460,24 +628,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = FileLoggerValidationError{}
+} = LogSamplingValidationError{}
 
-// Validate checks the field values on FileRotating with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
+// Validate checks the field values on StdLogger with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *FileRotating) Validate() error {
+func (m *StdLogger) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on FileRotating with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in FileRotatingMultiError, or
-// nil if none found.
-func (m *FileRotating) ValidateAll() error {
+// ValidateAll checks the field values on StdLogger with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in StdLoggerMultiError, or nil
+// if none found.
+func (m *StdLogger) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *FileRotating) validate(all bool) error {
+func (m *StdLogger) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -488,15 +656,990 @@ func (m *FileRotating) validate(all bool) error {
 		// no validation rules for Disable
 	}
 
-	if m.MaxSize != nil {
-		// no validation rules for MaxSize
+	if m.Level != nil {
+		// no validation rules for Level
 	}
 
-	if m.MaxFileAge != nil {
-		// no validation rules for MaxFileAge
+	if m.Async != nil {
+
+		if all {
+			switch v := interface{}(m.GetAsync()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, StdLoggerValidationError{
+						field:  "Async",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, StdLoggerValidationError{
+						field:  "Async",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetAsync()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StdLoggerValidationError{
+					field:  "Async",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
 	}
 
-	if m.MaxFiles != nil {
+	if m.Encoding != nil {
+		// no validation rules for Encoding
+	}
+
+	if m.Encoder != nil {
+
+		if all {
+			switch v := interface{}(m.GetEncoder()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, StdLoggerValidationError{
+						field:  "Encoder",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, StdLoggerValidationError{
+						field:  "Encoder",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetEncoder()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StdLoggerValidationError{
+					field:  "Encoder",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return StdLoggerMultiError(errors)
+	}
+
+	return nil
+}
+
+// StdLoggerMultiError is an error wrapping multiple validation errors returned
+// by StdLogger.ValidateAll() if the designated constraints aren't met.
+type StdLoggerMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StdLoggerMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StdLoggerMultiError) AllErrors() []error { return m }
+
+// StdLoggerValidationError is the validation error returned by
+// StdLogger.Validate if the designated constraints aren't met.
+type StdLoggerValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StdLoggerValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StdLoggerValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StdLoggerValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StdLoggerValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StdLoggerValidationError) ErrorName() string { return "StdLoggerValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StdLoggerValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStdLogger.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StdLoggerValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StdLoggerValidationError{}
+
+// Validate checks the field values on FileLogger with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *FileLogger) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FileLogger with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in FileLoggerMultiError, or
+// nil if none found.
+func (m *FileLogger) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FileLogger) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.Disable != nil {
+		// no validation rules for Disable
+	}
+
+	if m.Level != nil {
+		// no validation rules for Level
+	}
+
+	if m.Path != nil {
+		// no validation rules for Path
+	}
+
+	if m.Rotating != nil {
+
+		if all {
+			switch v := interface{}(m.GetRotating()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, FileLoggerValidationError{
+						field:  "Rotating",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, FileLoggerValidationError{
+						field:  "Rotating",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetRotating()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return FileLoggerValidationError{
+					field:  "Rotating",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.Async != nil {
+
+		if all {
+			switch v := interface{}(m.GetAsync()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, FileLoggerValidationError{
+						field:  "Async",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, FileLoggerValidationError{
+						field:  "Async",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetAsync()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return FileLoggerValidationError{
+					field:  "Async",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.Encoding != nil {
+		// no validation rules for Encoding
+	}
+
+	if m.Encoder != nil {
+
+		if all {
+			switch v := interface{}(m.GetEncoder()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, FileLoggerValidationError{
+						field:  "Encoder",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, FileLoggerValidationError{
+						field:  "Encoder",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetEncoder()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return FileLoggerValidationError{
+					field:  "Encoder",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.MaxLevel != nil {
+		// no validation rules for MaxLevel
+	}
+
+	if len(errors) > 0 {
+		return FileLoggerMultiError(errors)
+	}
+
+	return nil
+}
+
+// FileLoggerMultiError is an error wrapping multiple validation errors
+// returned by FileLogger.ValidateAll() if the designated constraints aren't met.
+type FileLoggerMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FileLoggerMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FileLoggerMultiError) AllErrors() []error { return m }
+
+// FileLoggerValidationError is the validation error returned by
+// FileLogger.Validate if the designated constraints aren't met.
+type FileLoggerValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FileLoggerValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FileLoggerValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FileLoggerValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FileLoggerValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FileLoggerValidationError) ErrorName() string { return "FileLoggerValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FileLoggerValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFileLogger.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FileLoggerValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FileLoggerValidationError{}
+
+// Validate checks the field values on OtlpLogger with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *OtlpLogger) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OtlpLogger with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in OtlpLoggerMultiError, or
+// nil if none found.
+func (m *OtlpLogger) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OtlpLogger) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.Disable != nil {
+		// no validation rules for Disable
+	}
+
+	if m.Level != nil {
+		// no validation rules for Level
+	}
+
+	if m.Exporter != nil {
+
+		if all {
+			switch v := interface{}(m.GetExporter()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, OtlpLoggerValidationError{
+						field:  "Exporter",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, OtlpLoggerValidationError{
+						field:  "Exporter",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetExporter()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return OtlpLoggerValidationError{
+					field:  "Exporter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.Batch != nil {
+
+		if all {
+			switch v := interface{}(m.GetBatch()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, OtlpLoggerValidationError{
+						field:  "Batch",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, OtlpLoggerValidationError{
+						field:  "Batch",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetBatch()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return OtlpLoggerValidationError{
+					field:  "Batch",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return OtlpLoggerMultiError(errors)
+	}
+
+	return nil
+}
+
+// OtlpLoggerMultiError is an error wrapping multiple validation errors
+// returned by OtlpLogger.ValidateAll() if the designated constraints aren't met.
+type OtlpLoggerMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OtlpLoggerMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OtlpLoggerMultiError) AllErrors() []error { return m }
+
+// OtlpLoggerValidationError is the validation error returned by
+// OtlpLogger.Validate if the designated constraints aren't met.
+type OtlpLoggerValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OtlpLoggerValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OtlpLoggerValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OtlpLoggerValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OtlpLoggerValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OtlpLoggerValidationError) ErrorName() string { return "OtlpLoggerValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OtlpLoggerValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOtlpLogger.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OtlpLoggerValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OtlpLoggerValidationError{}
+
+// Validate checks the field values on OtlpLogBatch with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *OtlpLogBatch) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OtlpLogBatch with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in OtlpLogBatchMultiError, or
+// nil if none found.
+func (m *OtlpLogBatch) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OtlpLogBatch) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.MaxQueueSize != nil {
+		// no validation rules for MaxQueueSize
+	}
+
+	if m.ExportInterval != nil {
+
+		if all {
+			switch v := interface{}(m.GetExportInterval()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, OtlpLogBatchValidationError{
+						field:  "ExportInterval",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, OtlpLogBatchValidationError{
+						field:  "ExportInterval",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetExportInterval()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return OtlpLogBatchValidationError{
+					field:  "ExportInterval",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.MaxExportBatchSize != nil {
+		// no validation rules for MaxExportBatchSize
+	}
+
+	if len(errors) > 0 {
+		return OtlpLogBatchMultiError(errors)
+	}
+
+	return nil
+}
+
+// OtlpLogBatchMultiError is an error wrapping multiple validation errors
+// returned by OtlpLogBatch.ValidateAll() if the designated constraints aren't met.
+type OtlpLogBatchMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OtlpLogBatchMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OtlpLogBatchMultiError) AllErrors() []error { return m }
+
+// OtlpLogBatchValidationError is the validation error returned by
+// OtlpLogBatch.Validate if the designated constraints aren't met.
+type OtlpLogBatchValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OtlpLogBatchValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OtlpLogBatchValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OtlpLogBatchValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OtlpLogBatchValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OtlpLogBatchValidationError) ErrorName() string { return "OtlpLogBatchValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OtlpLogBatchValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOtlpLogBatch.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OtlpLogBatchValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OtlpLogBatchValidationError{}
+
+// Validate checks the field values on LogEncoder with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *LogEncoder) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LogEncoder with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in LogEncoderMultiError, or
+// nil if none found.
+func (m *LogEncoder) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LogEncoder) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.TimeFormat != nil {
+		// no validation rules for TimeFormat
+	}
+
+	if m.MessageKey != nil {
+		// no validation rules for MessageKey
+	}
+
+	if m.LevelKey != nil {
+		// no validation rules for LevelKey
+	}
+
+	if m.LevelCase != nil {
+		// no validation rules for LevelCase
+	}
+
+	if m.Color != nil {
+		// no validation rules for Color
+	}
+
+	if len(errors) > 0 {
+		return LogEncoderMultiError(errors)
+	}
+
+	return nil
+}
+
+// LogEncoderMultiError is an error wrapping multiple validation errors
+// returned by LogEncoder.ValidateAll() if the designated constraints aren't met.
+type LogEncoderMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LogEncoderMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LogEncoderMultiError) AllErrors() []error { return m }
+
+// LogEncoderValidationError is the validation error returned by
+// LogEncoder.Validate if the designated constraints aren't met.
+type LogEncoderValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LogEncoderValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LogEncoderValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LogEncoderValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LogEncoderValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LogEncoderValidationError) ErrorName() string { return "LogEncoderValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LogEncoderValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLogEncoder.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LogEncoderValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LogEncoderValidationError{}
+
+// Validate checks the field values on LogAsync with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *LogAsync) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LogAsync with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in LogAsyncMultiError, or nil
+// if none found.
+func (m *LogAsync) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LogAsync) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.Disable != nil {
+		// no validation rules for Disable
+	}
+
+	if m.BufferSize != nil {
+		// no validation rules for BufferSize
+	}
+
+	if m.Overflow != nil {
+		// no validation rules for Overflow
+	}
+
+	if m.NeverDropErrors != nil {
+		// no validation rules for NeverDropErrors
+	}
+
+	if m.FlushTimeout != nil {
+
+		if all {
+			switch v := interface{}(m.GetFlushTimeout()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, LogAsyncValidationError{
+						field:  "FlushTimeout",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, LogAsyncValidationError{
+						field:  "FlushTimeout",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetFlushTimeout()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return LogAsyncValidationError{
+					field:  "FlushTimeout",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return LogAsyncMultiError(errors)
+	}
+
+	return nil
+}
+
+// LogAsyncMultiError is an error wrapping multiple validation errors returned
+// by LogAsync.ValidateAll() if the designated constraints aren't met.
+type LogAsyncMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LogAsyncMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LogAsyncMultiError) AllErrors() []error { return m }
+
+// LogAsyncValidationError is the validation error returned by
+// LogAsync.Validate if the designated constraints aren't met.
+type LogAsyncValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LogAsyncValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LogAsyncValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LogAsyncValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LogAsyncValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LogAsyncValidationError) ErrorName() string { return "LogAsyncValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LogAsyncValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLogAsync.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LogAsyncValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LogAsyncValidationError{}
+
+// Validate checks the field values on FileRotating with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *FileRotating) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FileRotating with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in FileRotatingMultiError, or
+// nil if none found.
+func (m *FileRotating) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FileRotating) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.Disable != nil {
+		// no validation rules for Disable
+	}
+
+	if m.MaxSize != nil {
+		// no validation rules for MaxSize
+	}
+
+	if m.MaxFileAge != nil {
+		// no validation rules for MaxFileAge
+	}
+
+	if m.MaxFiles != nil {
 		// no validation rules for MaxFiles
 	}
 
@@ -508,6 +1651,14 @@ func (m *FileRotating) validate(all bool) error {
 		// no validation rules for Compress
 	}
 
+	if m.Interval != nil {
+		// no validation rules for Interval
+	}
+
+	if m.Pattern != nil {
+		// no validation rules for Pattern
+	}
+
 	if len(errors) > 0 {
 		return FileRotatingMultiError(errors)
 	}
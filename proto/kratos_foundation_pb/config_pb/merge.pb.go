@@ -0,0 +1,264 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.32.0
+// 	protoc        v7.34.0
+// source: config_pb/merge.proto
+
+package config_pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	descriptorpb "google.golang.org/protobuf/types/descriptorpb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// 多个配置源之间的字段合并策略
+type MergeStrategy int32
+
+const (
+	// 默认策略：repeated 字段整体替换，map 和 message 字段按 key 递归合并
+	MergeStrategy_MERGE_STRATEGY_UNSPECIFIED MergeStrategy = 0
+	// 高优先级配置源的值整体替换低优先级的值
+	MergeStrategy_MERGE_STRATEGY_REPLACE MergeStrategy = 1
+	// repeated 字段按优先级从低到高追加，重复的元素只保留第一个
+	MergeStrategy_MERGE_STRATEGY_APPEND MergeStrategy = 2
+	// repeated message 字段按 keys 指定的字段匹配元素，匹配到的元素递归合并，其余元素追加在后面
+	// map 字段按 map 的 key 递归合并（与默认策略一致）
+	MergeStrategy_MERGE_STRATEGY_MERGE_BY_KEY MergeStrategy = 3
+)
+
+// Enum value maps for MergeStrategy.
+var (
+	MergeStrategy_name = map[int32]string{
+		0: "MERGE_STRATEGY_UNSPECIFIED",
+		1: "MERGE_STRATEGY_REPLACE",
+		2: "MERGE_STRATEGY_APPEND",
+		3: "MERGE_STRATEGY_MERGE_BY_KEY",
+	}
+	MergeStrategy_value = map[string]int32{
+		"MERGE_STRATEGY_UNSPECIFIED":  0,
+		"MERGE_STRATEGY_REPLACE":      1,
+		"MERGE_STRATEGY_APPEND":       2,
+		"MERGE_STRATEGY_MERGE_BY_KEY": 3,
+	}
+)
+
+func (x MergeStrategy) Enum() *MergeStrategy {
+	p := new(MergeStrategy)
+	*p = x
+	return p
+}
+
+func (x MergeStrategy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MergeStrategy) Descriptor() protoreflect.EnumDescriptor {
+	return file_config_pb_merge_proto_enumTypes[0].Descriptor()
+}
+
+func (MergeStrategy) Type() protoreflect.EnumType {
+	return &file_config_pb_merge_proto_enumTypes[0]
+}
+
+func (x MergeStrategy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MergeStrategy.Descriptor instead.
+func (MergeStrategy) EnumDescriptor() ([]byte, []int) {
+	return file_config_pb_merge_proto_rawDescGZIP(), []int{0}
+}
+
+// 字段合并规则
+type MergeRule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Strategy MergeStrategy `protobuf:"varint,1,opt,name=strategy,proto3,enum=kratos_foundation_pb.MergeStrategy" json:"strategy,omitempty"`
+	// MERGE_BY_KEY 时用于匹配元素的字段，按顺序取第一个有值的字段（用于 oneof）
+	Keys []string `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"`
+}
+
+func (x *MergeRule) Reset() {
+	*x = MergeRule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_pb_merge_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MergeRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeRule) ProtoMessage() {}
+
+func (x *MergeRule) ProtoReflect() protoreflect.Message {
+	mi := &file_config_pb_merge_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeRule.ProtoReflect.Descriptor instead.
+func (*MergeRule) Descriptor() ([]byte, []int) {
+	return file_config_pb_merge_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MergeRule) GetStrategy() MergeStrategy {
+	if x != nil {
+		return x.Strategy
+	}
+	return MergeStrategy_MERGE_STRATEGY_UNSPECIFIED
+}
+
+func (x *MergeRule) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+var file_config_pb_merge_proto_extTypes = []protoimpl.ExtensionInfo{
+	{
+		ExtendedType:  (*descriptorpb.FieldOptions)(nil),
+		ExtensionType: (*MergeRule)(nil),
+		Field:         52001,
+		Name:          "kratos_foundation_pb.merge",
+		Tag:           "bytes,52001,opt,name=merge",
+		Filename:      "config_pb/merge.proto",
+	},
+}
+
+// Extension fields to descriptorpb.FieldOptions.
+var (
+	// 示例：repeated RouteRule routes = 2 [(kratos_foundation_pb.merge) = {strategy: MERGE_STRATEGY_MERGE_BY_KEY, keys: ["path", "prefix"]}];
+	//
+	// optional kratos_foundation_pb.MergeRule merge = 52001;
+	E_Merge = &file_config_pb_merge_proto_extTypes[0]
+)
+
+var File_config_pb_merge_proto protoreflect.FileDescriptor
+
+var file_config_pb_merge_proto_rawDesc = []byte{
+	0x0a, 0x15, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x70, 0x62, 0x2f, 0x6d, 0x65, 0x72, 0x67,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x14, 0x6b, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x5f,
+	0x66, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x62, 0x1a, 0x20, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0x60, 0x0a, 0x09, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x3f, 0x0a, 0x08,
+	0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23,
+	0x2e, 0x6b, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x5f, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x53, 0x74, 0x72, 0x61, 0x74,
+	0x65, 0x67, 0x79, 0x52, 0x08, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x12, 0x0a,
+	0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x65, 0x79,
+	0x73, 0x2a, 0x87, 0x01, 0x0a, 0x0d, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x53, 0x74, 0x72, 0x61, 0x74,
+	0x65, 0x67, 0x79, 0x12, 0x1e, 0x0a, 0x1a, 0x4d, 0x45, 0x52, 0x47, 0x45, 0x5f, 0x53, 0x54, 0x52,
+	0x41, 0x54, 0x45, 0x47, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x4d, 0x45, 0x52, 0x47, 0x45, 0x5f, 0x53, 0x54, 0x52,
+	0x41, 0x54, 0x45, 0x47, 0x59, 0x5f, 0x52, 0x45, 0x50, 0x4c, 0x41, 0x43, 0x45, 0x10, 0x01, 0x12,
+	0x19, 0x0a, 0x15, 0x4d, 0x45, 0x52, 0x47, 0x45, 0x5f, 0x53, 0x54, 0x52, 0x41, 0x54, 0x45, 0x47,
+	0x59, 0x5f, 0x41, 0x50, 0x50, 0x45, 0x4e, 0x44, 0x10, 0x02, 0x12, 0x1f, 0x0a, 0x1b, 0x4d, 0x45,
+	0x52, 0x47, 0x45, 0x5f, 0x53, 0x54, 0x52, 0x41, 0x54, 0x45, 0x47, 0x59, 0x5f, 0x4d, 0x45, 0x52,
+	0x47, 0x45, 0x5f, 0x42, 0x59, 0x5f, 0x4b, 0x45, 0x59, 0x10, 0x03, 0x3a, 0x56, 0x0a, 0x05, 0x6d,
+	0x65, 0x72, 0x67, 0x65, 0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0xa1, 0x96, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6b, 0x72,
+	0x61, 0x74, 0x6f, 0x73, 0x5f, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x70, 0x62, 0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x05, 0x6d, 0x65,
+	0x72, 0x67, 0x65, 0x42, 0x54, 0x5a, 0x52, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x6a, 0x61, 0x67, 0x67, 0x65, 0x72, 0x7a, 0x68, 0x75, 0x61, 0x6e, 0x67, 0x31, 0x39,
+	0x39, 0x34, 0x2f, 0x6b, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2d, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6b, 0x72, 0x61, 0x74, 0x6f,
+	0x73, 0x5f, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x62, 0x2f,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_config_pb_merge_proto_rawDescOnce sync.Once
+	file_config_pb_merge_proto_rawDescData = file_config_pb_merge_proto_rawDesc
+)
+
+func file_config_pb_merge_proto_rawDescGZIP() []byte {
+	file_config_pb_merge_proto_rawDescOnce.Do(func() {
+		file_config_pb_merge_proto_rawDescData = protoimpl.X.CompressGZIP(file_config_pb_merge_proto_rawDescData)
+	})
+	return file_config_pb_merge_proto_rawDescData
+}
+
+var file_config_pb_merge_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_config_pb_merge_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_config_pb_merge_proto_goTypes = []interface{}{
+	(MergeStrategy)(0),                // 0: kratos_foundation_pb.MergeStrategy
+	(*MergeRule)(nil),                 // 1: kratos_foundation_pb.MergeRule
+	(*descriptorpb.FieldOptions)(nil), // 2: google.protobuf.FieldOptions
+}
+var file_config_pb_merge_proto_depIdxs = []int32{
+	0, // 0: kratos_foundation_pb.MergeRule.strategy:type_name -> kratos_foundation_pb.MergeStrategy
+	2, // 1: kratos_foundation_pb.merge:extendee -> google.protobuf.FieldOptions
+	1, // 2: kratos_foundation_pb.merge:type_name -> kratos_foundation_pb.MergeRule
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	2, // [2:3] is the sub-list for extension type_name
+	1, // [1:2] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_config_pb_merge_proto_init() }
+func file_config_pb_merge_proto_init() {
+	if File_config_pb_merge_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_config_pb_merge_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MergeRule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_config_pb_merge_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   1,
+			NumExtensions: 1,
+			NumServices:   0,
+		},
+		GoTypes:           file_config_pb_merge_proto_goTypes,
+		DependencyIndexes: file_config_pb_merge_proto_depIdxs,
+		EnumInfos:         file_config_pb_merge_proto_enumTypes,
+		MessageInfos:      file_config_pb_merge_proto_msgTypes,
+		ExtensionInfos:    file_config_pb_merge_proto_extTypes,
+	}.Build()
+	File_config_pb_merge_proto = out.File
+	file_config_pb_merge_proto_rawDesc = nil
+	file_config_pb_merge_proto_goTypes = nil
+	file_config_pb_merge_proto_depIdxs = nil
+}
@@ -0,0 +1,114 @@
+// Code generated by protoc-gen-kratos-foundation-errors. DO NOT EDIT.
+
+package config_pb
+
+import (
+	fmt "fmt"
+	errors "github.com/jaggerzhuang1994/kratos-foundation/pkg/errors"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the kratos package it is being compiled against.
+const _ = errors.SupportPackageIsVersion1
+
+// 默认策略：repeated 字段整体替换，map 和 message 字段按 key 递归合并
+func IsMergeStrategyUnspecified(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	if e == nil {
+		return false
+	}
+	return e.Code == 500 && e.Reason == "MERGE_STRATEGY_UNSPECIFIED" && e.Metadata != nil && e.Metadata["reason_code"] == "0"
+}
+
+// 默认策略：repeated 字段整体替换，map 和 message 字段按 key 递归合并
+func ErrorMergeStrategyUnspecified(formatAndArgs ...any) *errors.Error {
+	var format string
+	var args []any
+	if len(formatAndArgs) > 0 {
+		format = formatAndArgs[0].(string)
+		args = formatAndArgs[1:]
+	} else { // 如果没有传参数，则默认填充注释为错误原因
+		format = "默认策略：repeated 字段整体替换，map 和 message 字段按 key 递归合并"
+	}
+	return errors.New(500, "MERGE_STRATEGY_UNSPECIFIED", fmt.Sprintf(format, args...)).WithReasonCode(0).WithErrStack(4)
+}
+
+// 高优先级配置源的值整体替换低优先级的值
+func IsMergeStrategyReplace(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	if e == nil {
+		return false
+	}
+	return e.Code == 500 && e.Reason == "MERGE_STRATEGY_REPLACE" && e.Metadata != nil && e.Metadata["reason_code"] == "1"
+}
+
+// 高优先级配置源的值整体替换低优先级的值
+func ErrorMergeStrategyReplace(formatAndArgs ...any) *errors.Error {
+	var format string
+	var args []any
+	if len(formatAndArgs) > 0 {
+		format = formatAndArgs[0].(string)
+		args = formatAndArgs[1:]
+	} else { // 如果没有传参数，则默认填充注释为错误原因
+		format = "高优先级配置源的值整体替换低优先级的值"
+	}
+	return errors.New(500, "MERGE_STRATEGY_REPLACE", fmt.Sprintf(format, args...)).WithReasonCode(1).WithErrStack(4)
+}
+
+// repeated 字段按优先级从低到高追加，重复的元素只保留第一个
+func IsMergeStrategyAppend(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	if e == nil {
+		return false
+	}
+	return e.Code == 500 && e.Reason == "MERGE_STRATEGY_APPEND" && e.Metadata != nil && e.Metadata["reason_code"] == "2"
+}
+
+// repeated 字段按优先级从低到高追加，重复的元素只保留第一个
+func ErrorMergeStrategyAppend(formatAndArgs ...any) *errors.Error {
+	var format string
+	var args []any
+	if len(formatAndArgs) > 0 {
+		format = formatAndArgs[0].(string)
+		args = formatAndArgs[1:]
+	} else { // 如果没有传参数，则默认填充注释为错误原因
+		format = "repeated 字段按优先级从低到高追加，重复的元素只保留第一个"
+	}
+	return errors.New(500, "MERGE_STRATEGY_APPEND", fmt.Sprintf(format, args...)).WithReasonCode(2).WithErrStack(4)
+}
+
+// repeated message 字段按 keys 指定的字段匹配元素，匹配到的元素递归合并，其余元素追加在后面
+// map 字段按 map 的 key 递归合并（与默认策略一致）
+func IsMergeStrategyMergeByKey(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	if e == nil {
+		return false
+	}
+	return e.Code == 500 && e.Reason == "MERGE_STRATEGY_MERGE_BY_KEY" && e.Metadata != nil && e.Metadata["reason_code"] == "3"
+}
+
+// repeated message 字段按 keys 指定的字段匹配元素，匹配到的元素递归合并，其余元素追加在后面
+// map 字段按 map 的 key 递归合并（与默认策略一致）
+func ErrorMergeStrategyMergeByKey(formatAndArgs ...any) *errors.Error {
+	var format string
+	var args []any
+	if len(formatAndArgs) > 0 {
+		format = formatAndArgs[0].(string)
+		args = formatAndArgs[1:]
+	} else { // 如果没有传参数，则默认填充注释为错误原因
+		format = "repeated message 字段按 keys 指定的字段匹配元素，匹配到的元素递归合并，其余元素追加在后面\n// map 字段按 map 的 key 递归合并（与默认策略一致）"
+	}
+	return errors.New(500, "MERGE_STRATEGY_MERGE_BY_KEY", fmt.Sprintf(format, args...)).WithReasonCode(3).WithErrStack(4)
+}
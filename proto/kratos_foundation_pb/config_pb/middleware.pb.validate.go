@@ -162,6 +162,39 @@ func (m *Middleware_Metadata) validate(all bool) error {
 		// no validation rules for Disable
 	}
 
+	if m.LogLevel != nil {
+
+		if all {
+			switch v := interface{}(m.GetLogLevel()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, Middleware_MetadataValidationError{
+						field:  "LogLevel",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, Middleware_MetadataValidationError{
+						field:  "LogLevel",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetLogLevel()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return Middleware_MetadataValidationError{
+					field:  "LogLevel",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return Middleware_MetadataMultiError(errors)
 	}
@@ -1113,6 +1146,113 @@ var _ interface {
 	ErrorName() string
 } = Middleware_TimeoutValidationError{}
 
+// Validate checks the field values on Middleware_Metadata_LogLevel with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *Middleware_Metadata_LogLevel) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Middleware_Metadata_LogLevel with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// Middleware_Metadata_LogLevelMultiError, or nil if none found.
+func (m *Middleware_Metadata_LogLevel) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Middleware_Metadata_LogLevel) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.Secret != nil {
+		// no validation rules for Secret
+	}
+
+	if len(errors) > 0 {
+		return Middleware_Metadata_LogLevelMultiError(errors)
+	}
+
+	return nil
+}
+
+// Middleware_Metadata_LogLevelMultiError is an error wrapping multiple
+// validation errors returned by Middleware_Metadata_LogLevel.ValidateAll() if
+// the designated constraints aren't met.
+type Middleware_Metadata_LogLevelMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Middleware_Metadata_LogLevelMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Middleware_Metadata_LogLevelMultiError) AllErrors() []error { return m }
+
+// Middleware_Metadata_LogLevelValidationError is the validation error returned
+// by Middleware_Metadata_LogLevel.Validate if the designated constraints
+// aren't met.
+type Middleware_Metadata_LogLevelValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Middleware_Metadata_LogLevelValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Middleware_Metadata_LogLevelValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Middleware_Metadata_LogLevelValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Middleware_Metadata_LogLevelValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Middleware_Metadata_LogLevelValidationError) ErrorName() string {
+	return "Middleware_Metadata_LogLevelValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e Middleware_Metadata_LogLevelValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMiddleware_Metadata_LogLevel.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Middleware_Metadata_LogLevelValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Middleware_Metadata_LogLevelValidationError{}
+
 // Validate checks the field values on Middleware_RateLimit_BBRLimiter with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
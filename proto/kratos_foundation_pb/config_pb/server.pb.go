@@ -30,6 +30,7 @@ type Server struct {
 	// consul 服务发现会有1s左右延迟，所以在kratos生命周期中，
 	// 服务deregister之后，不能马上停止服务，需要延迟一段时间后在停止服务，否则服务监听者还没有拉取最新服务列表，服务已经停止。
 	// 因此给servers套上一个wrapper用于延迟停止服务。
+	// wrapper 会跟踪在途请求，在空闲达到 stop_quiet_period 后提前停止，stop_delay 为最长等待时间。
 	StopDelay *durationpb.Duration `protobuf:"bytes,1,opt,name=stop_delay,json=stopDelay,proto3,oneof" json:"stop_delay,omitempty"`
 	// 通用中间件配置
 	Middleware *ServerMiddleware `protobuf:"bytes,2,opt,name=middleware,proto3,oneof" json:"middleware,omitempty"`
@@ -39,6 +40,8 @@ type Server struct {
 	Grpc *GrpcServerOption `protobuf:"bytes,4,opt,name=grpc,proto3,oneof" json:"grpc,omitempty"`
 	// logger 配置
 	Log *ModuleLog `protobuf:"bytes,5,opt,name=log,proto3,oneof" json:"log,omitempty"`
+	// 停机静默期，停机开始后没有在途请求且持续 stop_quiet_period 没有新请求，则立即停止服务，默认 1s
+	StopQuietPeriod *durationpb.Duration `protobuf:"bytes,6,opt,name=stop_quiet_period,json=stopQuietPeriod,proto3,oneof" json:"stop_quiet_period,omitempty"`
 }
 
 func (x *Server) Reset() {
@@ -108,6 +111,13 @@ func (x *Server) GetLog() *ModuleLog {
 	return nil
 }
 
+func (x *Server) GetStopQuietPeriod() *durationpb.Duration {
+	if x != nil {
+		return x.StopQuietPeriod
+	}
+	return nil
+}
+
 // 服务器中间件配置
 type ServerMiddleware struct {
 	state         protoimpl.MessageState
@@ -222,6 +232,10 @@ type HttpServerOption struct {
 	PathPrefix *string `protobuf:"bytes,6,opt,name=path_prefix,json=pathPrefix,proto3,oneof" json:"path_prefix,omitempty"`
 	// metrics 路由
 	Metrics *HttpServerOption_Metrics `protobuf:"bytes,7,opt,name=metrics,proto3,oneof" json:"metrics,omitempty"`
+	// 健康检查路由
+	Health *HttpServerOption_Health `protobuf:"bytes,8,opt,name=health,proto3,oneof" json:"health,omitempty"`
+	// 管理接口（配置导出等），默认关闭
+	Admin *HttpServerOption_Admin `protobuf:"bytes,9,opt,name=admin,proto3,oneof" json:"admin,omitempty"`
 }
 
 func (x *HttpServerOption) Reset() {
@@ -305,6 +319,20 @@ func (x *HttpServerOption) GetMetrics() *HttpServerOption_Metrics {
 	return nil
 }
 
+func (x *HttpServerOption) GetHealth() *HttpServerOption_Health {
+	if x != nil {
+		return x.Health
+	}
+	return nil
+}
+
+func (x *HttpServerOption) GetAdmin() *HttpServerOption_Admin {
+	if x != nil {
+		return x.Admin
+	}
+	return nil
+}
+
 type GrpcServerOption struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -318,6 +346,7 @@ type GrpcServerOption struct {
 	// 对外暴露端点
 	Endpoint *Endpoint `protobuf:"bytes,4,opt,name=endpoint,proto3,oneof" json:"endpoint,omitempty"`
 	// customHealth 是否自定义健康检查，默认否（使用 grpc 自带的健康端点）
+	// 开启后使用 health 模块注册的检查项驱动 grpc.health.v1.Health 服务
 	CustomHealth *bool `protobuf:"varint,5,opt,name=custom_health,json=customHealth,proto3,oneof" json:"custom_health,omitempty"`
 	// disableReflection 是否禁用服务反射
 	DisableReflection *bool `protobuf:"varint,6,opt,name=disable_reflection,json=disableReflection,proto3,oneof" json:"disable_reflection,omitempty"`
@@ -454,6 +483,138 @@ func (x *HttpServerOption_Metrics) GetPath() string {
 	return ""
 }
 
+type HttpServerOption_Health struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 禁用
+	Disable *bool `protobuf:"varint,1,opt,name=disable,proto3,oneof" json:"disable,omitempty"`
+	// 存活检查路由，默认 /healthz
+	LivenessPath *string `protobuf:"bytes,2,opt,name=liveness_path,json=livenessPath,proto3,oneof" json:"liveness_path,omitempty"`
+	// 就绪检查路由，默认 /readyz
+	ReadinessPath *string `protobuf:"bytes,3,opt,name=readiness_path,json=readinessPath,proto3,oneof" json:"readiness_path,omitempty"`
+}
+
+func (x *HttpServerOption_Health) Reset() {
+	*x = HttpServerOption_Health{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_pb_server_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HttpServerOption_Health) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HttpServerOption_Health) ProtoMessage() {}
+
+func (x *HttpServerOption_Health) ProtoReflect() protoreflect.Message {
+	mi := &file_config_pb_server_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HttpServerOption_Health.ProtoReflect.Descriptor instead.
+func (*HttpServerOption_Health) Descriptor() ([]byte, []int) {
+	return file_config_pb_server_proto_rawDescGZIP(), []int{2, 1}
+}
+
+func (x *HttpServerOption_Health) GetDisable() bool {
+	if x != nil && x.Disable != nil {
+		return *x.Disable
+	}
+	return false
+}
+
+func (x *HttpServerOption_Health) GetLivenessPath() string {
+	if x != nil && x.LivenessPath != nil {
+		return *x.LivenessPath
+	}
+	return ""
+}
+
+func (x *HttpServerOption_Health) GetReadinessPath() string {
+	if x != nil && x.ReadinessPath != nil {
+		return *x.ReadinessPath
+	}
+	return ""
+}
+
+type HttpServerOption_Admin struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 禁用，默认 true
+	Disable *bool `protobuf:"varint,1,opt,name=disable,proto3,oneof" json:"disable,omitempty"`
+	// 管理接口路由前缀，默认 /admin
+	Path *string `protobuf:"bytes,2,opt,name=path,proto3,oneof" json:"path,omitempty"`
+	// 访问令牌，配置后请求需要携带 Authorization: Bearer {token}
+	Token *string `protobuf:"bytes,3,opt,name=token,proto3,oneof" json:"token,omitempty"`
+}
+
+func (x *HttpServerOption_Admin) Reset() {
+	*x = HttpServerOption_Admin{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_pb_server_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HttpServerOption_Admin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HttpServerOption_Admin) ProtoMessage() {}
+
+func (x *HttpServerOption_Admin) ProtoReflect() protoreflect.Message {
+	mi := &file_config_pb_server_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HttpServerOption_Admin.ProtoReflect.Descriptor instead.
+func (*HttpServerOption_Admin) Descriptor() ([]byte, []int) {
+	return file_config_pb_server_proto_rawDescGZIP(), []int{2, 2}
+}
+
+func (x *HttpServerOption_Admin) GetDisable() bool {
+	if x != nil && x.Disable != nil {
+		return *x.Disable
+	}
+	return false
+}
+
+func (x *HttpServerOption_Admin) GetPath() string {
+	if x != nil && x.Path != nil {
+		return *x.Path
+	}
+	return ""
+}
+
+func (x *HttpServerOption_Admin) GetToken() string {
+	if x != nil && x.Token != nil {
+		return *x.Token
+	}
+	return ""
+}
+
 var File_config_pb_server_proto protoreflect.FileDescriptor
 
 var file_config_pb_server_proto_rawDesc = []byte{
@@ -466,7 +627,7 @@ var file_config_pb_server_proto_rawDesc = []byte{
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x16, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x70, 0x62,
 	0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1a, 0x63,
 	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x70, 0x62, 0x2f, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77,
-	0x61, 0x72, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x86, 0x03, 0x0a, 0x06, 0x53, 0x65,
+	0x61, 0x72, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe8, 0x03, 0x0a, 0x06, 0x53, 0x65,
 	0x72, 0x76, 0x65, 0x72, 0x12, 0x3d, 0x0a, 0x0a, 0x73, 0x74, 0x6f, 0x70, 0x5f, 0x64, 0x65, 0x6c,
 	0x61, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
 	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
@@ -487,117 +648,153 @@ var file_config_pb_server_proto_rawDesc = []byte{
 	0x01, 0x01, 0x12, 0x36, 0x0a, 0x03, 0x6c, 0x6f, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x1f, 0x2e, 0x6b, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x5f, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x74,
 	0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x62, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x4c, 0x6f, 0x67,
-	0x48, 0x04, 0x52, 0x03, 0x6c, 0x6f, 0x67, 0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x73,
-	0x74, 0x6f, 0x70, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6d, 0x69,
-	0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x68, 0x74, 0x74,
-	0x70, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x6c,
-	0x6f, 0x67, 0x22, 0xfb, 0x04, 0x0a, 0x10, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x69, 0x64,
-	0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x12, 0x47, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f,
-	0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6b, 0x72, 0x61, 0x74, 0x6f,
-	0x73, 0x5f, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x62, 0x2e,
-	0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x6f,
-	0x75, 0x74, 0x48, 0x00, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x88, 0x01, 0x01,
-	0x12, 0x4a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6b, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x5f, 0x66, 0x6f, 0x75, 0x6e,
-	0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x62, 0x2e, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65,
-	0x77, 0x61, 0x72, 0x65, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x48, 0x01, 0x52,
-	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x88, 0x01, 0x01, 0x12, 0x47, 0x0a, 0x07,
-	0x74, 0x72, 0x61, 0x63, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e,
-	0x6b, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x5f, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x5f, 0x70, 0x62, 0x2e, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x2e,
-	0x54, 0x72, 0x61, 0x63, 0x69, 0x6e, 0x67, 0x48, 0x02, 0x52, 0x07, 0x74, 0x72, 0x61, 0x63, 0x69,
-	0x6e, 0x67, 0x88, 0x01, 0x01, 0x12, 0x47, 0x0a, 0x07, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6b, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x5f,
-	0x66, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x62, 0x2e, 0x4d, 0x69,
-	0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73,
-	0x48, 0x03, 0x52, 0x07, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x88, 0x01, 0x01, 0x12, 0x47,
-	0x0a, 0x07, 0x6c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x48, 0x04, 0x52, 0x03, 0x6c, 0x6f, 0x67, 0x88, 0x01, 0x01, 0x12, 0x4a, 0x0a, 0x11, 0x73, 0x74,
+	0x6f, 0x70, 0x5f, 0x71, 0x75, 0x69, 0x65, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x48, 0x05, 0x52, 0x0f, 0x73, 0x74, 0x6f, 0x70, 0x51, 0x75, 0x69, 0x65, 0x74, 0x50, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x73, 0x74, 0x6f, 0x70, 0x5f,
+	0x64, 0x65, 0x6c, 0x61, 0x79, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65,
+	0x77, 0x61, 0x72, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x68, 0x74, 0x74, 0x70, 0x42, 0x07, 0x0a,
+	0x05, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x6c, 0x6f, 0x67, 0x42, 0x14,
+	0x0a, 0x12, 0x5f, 0x73, 0x74, 0x6f, 0x70, 0x5f, 0x71, 0x75, 0x69, 0x65, 0x74, 0x5f, 0x70, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x22, 0xfb, 0x04, 0x0a, 0x10, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d,
+	0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x12, 0x47, 0x0a, 0x07, 0x74, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6b, 0x72, 0x61,
+	0x74, 0x6f, 0x73, 0x5f, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70,
+	0x62, 0x2e, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x48, 0x00, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x88,
+	0x01, 0x01, 0x12, 0x4a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6b, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x5f, 0x66, 0x6f,
+	0x75, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x62, 0x2e, 0x4d, 0x69, 0x64, 0x64,
+	0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x48,
+	0x01, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x88, 0x01, 0x01, 0x12, 0x47,
+	0x0a, 0x07, 0x74, 0x72, 0x61, 0x63, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x28, 0x2e, 0x6b, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x5f, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x74,
 	0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x62, 0x2e, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72,
-	0x65, 0x2e, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x48, 0x04, 0x52, 0x07, 0x6c, 0x6f, 0x67,
-	0x67, 0x69, 0x6e, 0x67, 0x88, 0x01, 0x01, 0x12, 0x4d, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6b, 0x72, 0x61,
-	0x74, 0x6f, 0x73, 0x5f, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70,
-	0x62, 0x2e, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x2e, 0x56, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x48, 0x05, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12, 0x4e, 0x0a, 0x0a, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x6c,
-	0x69, 0x6d, 0x69, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6b, 0x72, 0x61,
-	0x74, 0x6f, 0x73, 0x5f, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70,
-	0x62, 0x2e, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x2e, 0x52, 0x61, 0x74,
-	0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x48, 0x06, 0x52, 0x09, 0x72, 0x61, 0x74, 0x65, 0x4c, 0x69,
-	0x6d, 0x69, 0x74, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f,
-	0x75, 0x74, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42,
-	0x0a, 0x0a, 0x08, 0x5f, 0x74, 0x72, 0x61, 0x63, 0x69, 0x6e, 0x67, 0x42, 0x0a, 0x0a, 0x08, 0x5f,
-	0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6c, 0x6f, 0x67, 0x67,
-	0x69, 0x6e, 0x67, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
-	0x72, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74,
-	0x22, 0xbc, 0x04, 0x0a, 0x10, 0x48, 0x74, 0x74, 0x70, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x07, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x07, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c,
-	0x65, 0x88, 0x01, 0x01, 0x12, 0x48, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x29, 0xfa, 0xc4, 0x05, 0x25, 0x6a, 0x23, 0x2a, 0x03, 0x74,
-	0x63, 0x70, 0x2a, 0x04, 0x74, 0x63, 0x70, 0x34, 0x2a, 0x04, 0x74, 0x63, 0x70, 0x36, 0x2a, 0x04,
-	0x75, 0x6e, 0x69, 0x78, 0x2a, 0x0a, 0x75, 0x6e, 0x69, 0x78, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74,
-	0x48, 0x01, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x88, 0x01, 0x01, 0x12, 0x17,
-	0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x04,
-	0x61, 0x64, 0x64, 0x72, 0x88, 0x01, 0x01, 0x12, 0x3f, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f,
-	0x69, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6b, 0x72, 0x61, 0x74,
-	0x6f, 0x73, 0x5f, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x62,
-	0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x48, 0x03, 0x52, 0x08, 0x65, 0x6e, 0x64,
-	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x35, 0x0a, 0x14, 0x64, 0x69, 0x73, 0x61,
-	0x62, 0x6c, 0x65, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x5f, 0x73, 0x6c, 0x61, 0x73, 0x68,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x48, 0x04, 0x52, 0x12, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c,
-	0x65, 0x53, 0x74, 0x72, 0x69, 0x63, 0x74, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x88, 0x01, 0x01, 0x12,
-	0x24, 0x0a, 0x0b, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x09, 0x48, 0x05, 0x52, 0x0a, 0x70, 0x61, 0x74, 0x68, 0x50, 0x72, 0x65, 0x66,
-	0x69, 0x78, 0x88, 0x01, 0x01, 0x12, 0x4d, 0x0a, 0x07, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x6b, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x5f,
-	0x66, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x62, 0x2e, 0x48, 0x74,
-	0x74, 0x70, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x4d,
-	0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x48, 0x06, 0x52, 0x07, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63,
-	0x73, 0x88, 0x01, 0x01, 0x1a, 0x56, 0x0a, 0x07, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12,
-	0x1d, 0x0a, 0x07, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
-	0x48, 0x00, 0x52, 0x07, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x12, 0x17,
-	0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x04,
-	0x70, 0x61, 0x74, 0x68, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x64, 0x69, 0x73, 0x61,
-	0x62, 0x6c, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x42, 0x0a, 0x0a, 0x08,
-	0x5f, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6e, 0x65, 0x74,
-	0x77, 0x6f, 0x72, 0x6b, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x42, 0x0b, 0x0a,
-	0x09, 0x5f, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x42, 0x17, 0x0a, 0x15, 0x5f, 0x64,
-	0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x5f, 0x73, 0x6c,
-	0x61, 0x73, 0x68, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x70, 0x72, 0x65,
-	0x66, 0x69, 0x78, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x22,
-	0x8a, 0x03, 0x0a, 0x10, 0x47, 0x72, 0x70, 0x63, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4f, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x07, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x07, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65,
-	0x88, 0x01, 0x01, 0x12, 0x48, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x29, 0xfa, 0xc4, 0x05, 0x25, 0x6a, 0x23, 0x2a, 0x03, 0x74, 0x63,
-	0x70, 0x2a, 0x04, 0x74, 0x63, 0x70, 0x34, 0x2a, 0x04, 0x74, 0x63, 0x70, 0x36, 0x2a, 0x04, 0x75,
-	0x6e, 0x69, 0x78, 0x2a, 0x0a, 0x75, 0x6e, 0x69, 0x78, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x48,
-	0x01, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a,
-	0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x04, 0x61,
-	0x64, 0x64, 0x72, 0x88, 0x01, 0x01, 0x12, 0x3f, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69,
-	0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6b, 0x72, 0x61, 0x74, 0x6f,
+	0x65, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x69, 0x6e, 0x67, 0x48, 0x02, 0x52, 0x07, 0x74, 0x72, 0x61,
+	0x63, 0x69, 0x6e, 0x67, 0x88, 0x01, 0x01, 0x12, 0x47, 0x0a, 0x07, 0x6d, 0x65, 0x74, 0x72, 0x69,
+	0x63, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6b, 0x72, 0x61, 0x74, 0x6f,
+	0x73, 0x5f, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x62, 0x2e,
+	0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69,
+	0x63, 0x73, 0x48, 0x03, 0x52, 0x07, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x88, 0x01, 0x01,
+	0x12, 0x47, 0x0a, 0x07, 0x6c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x28, 0x2e, 0x6b, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x5f, 0x66, 0x6f, 0x75, 0x6e, 0x64,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x62, 0x2e, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77,
+	0x61, 0x72, 0x65, 0x2e, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x48, 0x04, 0x52, 0x07, 0x6c,
+	0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x88, 0x01, 0x01, 0x12, 0x4d, 0x0a, 0x09, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6b,
+	0x72, 0x61, 0x74, 0x6f, 0x73, 0x5f, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x70, 0x62, 0x2e, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x2e, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x48, 0x05, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12, 0x4e, 0x0a, 0x0a, 0x72, 0x61, 0x74, 0x65,
+	0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6b,
+	0x72, 0x61, 0x74, 0x6f, 0x73, 0x5f, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x70, 0x62, 0x2e, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x2e, 0x52,
+	0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x48, 0x06, 0x52, 0x09, 0x72, 0x61, 0x74, 0x65,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x74, 0x72, 0x61, 0x63, 0x69, 0x6e, 0x67, 0x42, 0x0a, 0x0a,
+	0x08, 0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6c, 0x6f,
+	0x67, 0x67, 0x69, 0x6e, 0x67, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x22, 0x92, 0x08, 0x0a, 0x10, 0x48, 0x74, 0x74, 0x70, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x07, 0x64, 0x69, 0x73, 0x61, 0x62,
+	0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x07, 0x64, 0x69, 0x73, 0x61,
+	0x62, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x12, 0x48, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x29, 0xfa, 0xc4, 0x05, 0x25, 0x6a, 0x23, 0x2a,
+	0x03, 0x74, 0x63, 0x70, 0x2a, 0x04, 0x74, 0x63, 0x70, 0x34, 0x2a, 0x04, 0x74, 0x63, 0x70, 0x36,
+	0x2a, 0x04, 0x75, 0x6e, 0x69, 0x78, 0x2a, 0x0a, 0x75, 0x6e, 0x69, 0x78, 0x70, 0x61, 0x63, 0x6b,
+	0x65, 0x74, 0x48, 0x01, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x88, 0x01, 0x01,
+	0x12, 0x17, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02,
+	0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x88, 0x01, 0x01, 0x12, 0x3f, 0x0a, 0x08, 0x65, 0x6e, 0x64,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6b, 0x72,
+	0x61, 0x74, 0x6f, 0x73, 0x5f, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x70, 0x62, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x48, 0x03, 0x52, 0x08, 0x65,
+	0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x35, 0x0a, 0x14, 0x64, 0x69,
+	0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x5f, 0x73, 0x6c, 0x61,
+	0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x48, 0x04, 0x52, 0x12, 0x64, 0x69, 0x73, 0x61,
+	0x62, 0x6c, 0x65, 0x53, 0x74, 0x72, 0x69, 0x63, 0x74, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x88, 0x01,
+	0x01, 0x12, 0x24, 0x0a, 0x0b, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x05, 0x52, 0x0a, 0x70, 0x61, 0x74, 0x68, 0x50, 0x72,
+	0x65, 0x66, 0x69, 0x78, 0x88, 0x01, 0x01, 0x12, 0x4d, 0x0a, 0x07, 0x6d, 0x65, 0x74, 0x72, 0x69,
+	0x63, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x6b, 0x72, 0x61, 0x74, 0x6f,
 	0x73, 0x5f, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x62, 0x2e,
-	0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x48, 0x03, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x70,
-	0x6f, 0x69, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x0d, 0x63, 0x75, 0x73, 0x74, 0x6f,
-	0x6d, 0x5f, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x48, 0x04,
-	0x52, 0x0c, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x88, 0x01,
-	0x01, 0x12, 0x32, 0x0a, 0x12, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x72, 0x65, 0x66,
-	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x48, 0x05, 0x52,
-	0x11, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x66, 0x6c, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c,
-	0x65, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x42, 0x07, 0x0a,
-	0x05, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x65, 0x6e, 0x64, 0x70, 0x6f,
-	0x69, 0x6e, 0x74, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x68,
-	0x65, 0x61, 0x6c, 0x74, 0x68, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c,
-	0x65, 0x5f, 0x72, 0x65, 0x66, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x54, 0x5a, 0x52,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6a, 0x61, 0x67, 0x67, 0x65,
-	0x72, 0x7a, 0x68, 0x75, 0x61, 0x6e, 0x67, 0x31, 0x39, 0x39, 0x34, 0x2f, 0x6b, 0x72, 0x61, 0x74,
-	0x6f, 0x73, 0x2d, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2f, 0x6b, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x5f, 0x66, 0x6f, 0x75, 0x6e, 0x64,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x62, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f,
-	0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x48, 0x74, 0x74, 0x70, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x48, 0x06, 0x52, 0x07, 0x6d, 0x65, 0x74, 0x72,
+	0x69, 0x63, 0x73, 0x88, 0x01, 0x01, 0x12, 0x4a, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6b, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x5f,
+	0x66, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x62, 0x2e, 0x48, 0x74,
+	0x74, 0x70, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x48,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x48, 0x07, 0x52, 0x06, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x88,
+	0x01, 0x01, 0x12, 0x47, 0x0a, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2c, 0x2e, 0x6b, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x5f, 0x66, 0x6f, 0x75, 0x6e, 0x64,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x62, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x48,
+	0x08, 0x52, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x88, 0x01, 0x01, 0x1a, 0x56, 0x0a, 0x07, 0x4d,
+	0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x1d, 0x0a, 0x07, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x07, 0x64, 0x69, 0x73, 0x61, 0x62,
+	0x6c, 0x65, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x88, 0x01, 0x01, 0x42, 0x0a,
+	0x0a, 0x08, 0x5f, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x70,
+	0x61, 0x74, 0x68, 0x1a, 0xae, 0x01, 0x0a, 0x06, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x1d,
+	0x0a, 0x07, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x48,
+	0x00, 0x52, 0x07, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a,
+	0x0d, 0x6c, 0x69, 0x76, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0c, 0x6c, 0x69, 0x76, 0x65, 0x6e, 0x65, 0x73, 0x73,
+	0x50, 0x61, 0x74, 0x68, 0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a, 0x0e, 0x72, 0x65, 0x61, 0x64, 0x69,
+	0x6e, 0x65, 0x73, 0x73, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x02, 0x52, 0x0d, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x50, 0x61, 0x74, 0x68,
+	0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x42,
+	0x10, 0x0a, 0x0e, 0x5f, 0x6c, 0x69, 0x76, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x70, 0x61, 0x74,
+	0x68, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x5f,
+	0x70, 0x61, 0x74, 0x68, 0x1a, 0x79, 0x0a, 0x05, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x1d, 0x0a,
+	0x07, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00,
+	0x52, 0x07, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04,
+	0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x04, 0x70, 0x61,
+	0x74, 0x68, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x88, 0x01, 0x01,
+	0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x07, 0x0a, 0x05,
+	0x5f, 0x70, 0x61, 0x74, 0x68, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x42,
+	0x0a, 0x0a, 0x08, 0x5f, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x5f,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x42, 0x17, 0x0a,
+	0x15, 0x5f, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74,
+	0x5f, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x5f,
+	0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69,
+	0x63, 0x73, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x42, 0x08, 0x0a,
+	0x06, 0x5f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x22, 0x8a, 0x03, 0x0a, 0x10, 0x47, 0x72, 0x70, 0x63,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x07,
+	0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52,
+	0x07, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x12, 0x48, 0x0a, 0x07, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x29, 0xfa, 0xc4,
+	0x05, 0x25, 0x6a, 0x23, 0x2a, 0x03, 0x74, 0x63, 0x70, 0x2a, 0x04, 0x74, 0x63, 0x70, 0x34, 0x2a,
+	0x04, 0x74, 0x63, 0x70, 0x36, 0x2a, 0x04, 0x75, 0x6e, 0x69, 0x78, 0x2a, 0x0a, 0x75, 0x6e, 0x69,
+	0x78, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x48, 0x01, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x88, 0x01, 0x01, 0x12, 0x3f,
+	0x0a, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x6b, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x5f, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x62, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x48, 0x03, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12,
+	0x28, 0x0a, 0x0d, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x48, 0x04, 0x52, 0x0c, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d,
+	0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x88, 0x01, 0x01, 0x12, 0x32, 0x0a, 0x12, 0x64, 0x69, 0x73,
+	0x61, 0x62, 0x6c, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x08, 0x48, 0x05, 0x52, 0x11, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65,
+	0x52, 0x65, 0x66, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a,
+	0x08, 0x5f, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x42, 0x0b,
+	0x0a, 0x09, 0x5f, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x42, 0x10, 0x0a, 0x0e, 0x5f,
+	0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x42, 0x15, 0x0a,
+	0x13, 0x5f, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x54, 0x5a, 0x52, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x6a, 0x61, 0x67, 0x67, 0x65, 0x72, 0x7a, 0x68, 0x75, 0x61, 0x6e, 0x67, 0x31,
+	0x39, 0x39, 0x34, 0x2f, 0x6b, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2d, 0x66, 0x6f, 0x75, 0x6e, 0x64,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6b, 0x72, 0x61, 0x74,
+	0x6f, 0x73, 0x5f, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x62,
+	0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -612,45 +809,50 @@ func file_config_pb_server_proto_rawDescGZIP() []byte {
 	return file_config_pb_server_proto_rawDescData
 }
 
-var file_config_pb_server_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_config_pb_server_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_config_pb_server_proto_goTypes = []interface{}{
 	(*Server)(nil),                   // 0: kratos_foundation_pb.Server
 	(*ServerMiddleware)(nil),         // 1: kratos_foundation_pb.ServerMiddleware
 	(*HttpServerOption)(nil),         // 2: kratos_foundation_pb.HttpServerOption
 	(*GrpcServerOption)(nil),         // 3: kratos_foundation_pb.GrpcServerOption
 	(*HttpServerOption_Metrics)(nil), // 4: kratos_foundation_pb.HttpServerOption.Metrics
-	(*durationpb.Duration)(nil),      // 5: google.protobuf.Duration
-	(*ModuleLog)(nil),                // 6: kratos_foundation_pb.ModuleLog
-	(*Middleware_Timeout)(nil),       // 7: kratos_foundation_pb.Middleware.Timeout
-	(*Middleware_Metadata)(nil),      // 8: kratos_foundation_pb.Middleware.Metadata
-	(*Middleware_Tracing)(nil),       // 9: kratos_foundation_pb.Middleware.Tracing
-	(*Middleware_Metrics)(nil),       // 10: kratos_foundation_pb.Middleware.Metrics
-	(*Middleware_Logging)(nil),       // 11: kratos_foundation_pb.Middleware.Logging
-	(*Middleware_Validator)(nil),     // 12: kratos_foundation_pb.Middleware.Validator
-	(*Middleware_RateLimit)(nil),     // 13: kratos_foundation_pb.Middleware.RateLimit
-	(*Endpoint)(nil),                 // 14: kratos_foundation_pb.Endpoint
+	(*HttpServerOption_Health)(nil),  // 5: kratos_foundation_pb.HttpServerOption.Health
+	(*HttpServerOption_Admin)(nil),   // 6: kratos_foundation_pb.HttpServerOption.Admin
+	(*durationpb.Duration)(nil),      // 7: google.protobuf.Duration
+	(*ModuleLog)(nil),                // 8: kratos_foundation_pb.ModuleLog
+	(*Middleware_Timeout)(nil),       // 9: kratos_foundation_pb.Middleware.Timeout
+	(*Middleware_Metadata)(nil),      // 10: kratos_foundation_pb.Middleware.Metadata
+	(*Middleware_Tracing)(nil),       // 11: kratos_foundation_pb.Middleware.Tracing
+	(*Middleware_Metrics)(nil),       // 12: kratos_foundation_pb.Middleware.Metrics
+	(*Middleware_Logging)(nil),       // 13: kratos_foundation_pb.Middleware.Logging
+	(*Middleware_Validator)(nil),     // 14: kratos_foundation_pb.Middleware.Validator
+	(*Middleware_RateLimit)(nil),     // 15: kratos_foundation_pb.Middleware.RateLimit
+	(*Endpoint)(nil),                 // 16: kratos_foundation_pb.Endpoint
 }
 var file_config_pb_server_proto_depIdxs = []int32{
-	5,  // 0: kratos_foundation_pb.Server.stop_delay:type_name -> google.protobuf.Duration
+	7,  // 0: kratos_foundation_pb.Server.stop_delay:type_name -> google.protobuf.Duration
 	1,  // 1: kratos_foundation_pb.Server.middleware:type_name -> kratos_foundation_pb.ServerMiddleware
 	2,  // 2: kratos_foundation_pb.Server.http:type_name -> kratos_foundation_pb.HttpServerOption
 	3,  // 3: kratos_foundation_pb.Server.grpc:type_name -> kratos_foundation_pb.GrpcServerOption
-	6,  // 4: kratos_foundation_pb.Server.log:type_name -> kratos_foundation_pb.ModuleLog
-	7,  // 5: kratos_foundation_pb.ServerMiddleware.timeout:type_name -> kratos_foundation_pb.Middleware.Timeout
-	8,  // 6: kratos_foundation_pb.ServerMiddleware.metadata:type_name -> kratos_foundation_pb.Middleware.Metadata
-	9,  // 7: kratos_foundation_pb.ServerMiddleware.tracing:type_name -> kratos_foundation_pb.Middleware.Tracing
-	10, // 8: kratos_foundation_pb.ServerMiddleware.metrics:type_name -> kratos_foundation_pb.Middleware.Metrics
-	11, // 9: kratos_foundation_pb.ServerMiddleware.logging:type_name -> kratos_foundation_pb.Middleware.Logging
-	12, // 10: kratos_foundation_pb.ServerMiddleware.validator:type_name -> kratos_foundation_pb.Middleware.Validator
-	13, // 11: kratos_foundation_pb.ServerMiddleware.rate_limit:type_name -> kratos_foundation_pb.Middleware.RateLimit
-	14, // 12: kratos_foundation_pb.HttpServerOption.endpoint:type_name -> kratos_foundation_pb.Endpoint
-	4,  // 13: kratos_foundation_pb.HttpServerOption.metrics:type_name -> kratos_foundation_pb.HttpServerOption.Metrics
-	14, // 14: kratos_foundation_pb.GrpcServerOption.endpoint:type_name -> kratos_foundation_pb.Endpoint
-	15, // [15:15] is the sub-list for method output_type
-	15, // [15:15] is the sub-list for method input_type
-	15, // [15:15] is the sub-list for extension type_name
-	15, // [15:15] is the sub-list for extension extendee
-	0,  // [0:15] is the sub-list for field type_name
+	8,  // 4: kratos_foundation_pb.Server.log:type_name -> kratos_foundation_pb.ModuleLog
+	7,  // 5: kratos_foundation_pb.Server.stop_quiet_period:type_name -> google.protobuf.Duration
+	9,  // 6: kratos_foundation_pb.ServerMiddleware.timeout:type_name -> kratos_foundation_pb.Middleware.Timeout
+	10, // 7: kratos_foundation_pb.ServerMiddleware.metadata:type_name -> kratos_foundation_pb.Middleware.Metadata
+	11, // 8: kratos_foundation_pb.ServerMiddleware.tracing:type_name -> kratos_foundation_pb.Middleware.Tracing
+	12, // 9: kratos_foundation_pb.ServerMiddleware.metrics:type_name -> kratos_foundation_pb.Middleware.Metrics
+	13, // 10: kratos_foundation_pb.ServerMiddleware.logging:type_name -> kratos_foundation_pb.Middleware.Logging
+	14, // 11: kratos_foundation_pb.ServerMiddleware.validator:type_name -> kratos_foundation_pb.Middleware.Validator
+	15, // 12: kratos_foundation_pb.ServerMiddleware.rate_limit:type_name -> kratos_foundation_pb.Middleware.RateLimit
+	16, // 13: kratos_foundation_pb.HttpServerOption.endpoint:type_name -> kratos_foundation_pb.Endpoint
+	4,  // 14: kratos_foundation_pb.HttpServerOption.metrics:type_name -> kratos_foundation_pb.HttpServerOption.Metrics
+	5,  // 15: kratos_foundation_pb.HttpServerOption.health:type_name -> kratos_foundation_pb.HttpServerOption.Health
+	6,  // 16: kratos_foundation_pb.HttpServerOption.admin:type_name -> kratos_foundation_pb.HttpServerOption.Admin
+	16, // 17: kratos_foundation_pb.GrpcServerOption.endpoint:type_name -> kratos_foundation_pb.Endpoint
+	18, // [18:18] is the sub-list for method output_type
+	18, // [18:18] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
 }
 
 func init() { file_config_pb_server_proto_init() }
@@ -721,19 +923,45 @@ func file_config_pb_server_proto_init() {
 				return nil
 			}
 		}
+		file_config_pb_server_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HttpServerOption_Health); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_pb_server_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HttpServerOption_Admin); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_config_pb_server_proto_msgTypes[0].OneofWrappers = []interface{}{}
 	file_config_pb_server_proto_msgTypes[1].OneofWrappers = []interface{}{}
 	file_config_pb_server_proto_msgTypes[2].OneofWrappers = []interface{}{}
 	file_config_pb_server_proto_msgTypes[3].OneofWrappers = []interface{}{}
 	file_config_pb_server_proto_msgTypes[4].OneofWrappers = []interface{}{}
+	file_config_pb_server_proto_msgTypes[5].OneofWrappers = []interface{}{}
+	file_config_pb_server_proto_msgTypes[6].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_config_pb_server_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
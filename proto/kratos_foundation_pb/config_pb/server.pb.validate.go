@@ -221,6 +221,39 @@ func (m *Server) validate(all bool) error {
 
 	}
 
+	if m.StopQuietPeriod != nil {
+
+		if all {
+			switch v := interface{}(m.GetStopQuietPeriod()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ServerValidationError{
+						field:  "StopQuietPeriod",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ServerValidationError{
+						field:  "StopQuietPeriod",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetStopQuietPeriod()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ServerValidationError{
+					field:  "StopQuietPeriod",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return ServerMultiError(errors)
 	}
@@ -737,6 +770,72 @@ func (m *HttpServerOption) validate(all bool) error {
 
 	}
 
+	if m.Health != nil {
+
+		if all {
+			switch v := interface{}(m.GetHealth()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, HttpServerOptionValidationError{
+						field:  "Health",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, HttpServerOptionValidationError{
+						field:  "Health",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetHealth()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return HttpServerOptionValidationError{
+					field:  "Health",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.Admin != nil {
+
+		if all {
+			switch v := interface{}(m.GetAdmin()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, HttpServerOptionValidationError{
+						field:  "Admin",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, HttpServerOptionValidationError{
+						field:  "Admin",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetAdmin()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return HttpServerOptionValidationError{
+					field:  "Admin",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return HttpServerOptionMultiError(errors)
 	}
@@ -1077,3 +1176,231 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = HttpServerOption_MetricsValidationError{}
+
+// Validate checks the field values on HttpServerOption_Health with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *HttpServerOption_Health) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on HttpServerOption_Health with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// HttpServerOption_HealthMultiError, or nil if none found.
+func (m *HttpServerOption_Health) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *HttpServerOption_Health) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.Disable != nil {
+		// no validation rules for Disable
+	}
+
+	if m.LivenessPath != nil {
+		// no validation rules for LivenessPath
+	}
+
+	if m.ReadinessPath != nil {
+		// no validation rules for ReadinessPath
+	}
+
+	if len(errors) > 0 {
+		return HttpServerOption_HealthMultiError(errors)
+	}
+
+	return nil
+}
+
+// HttpServerOption_HealthMultiError is an error wrapping multiple validation
+// errors returned by HttpServerOption_Health.ValidateAll() if the designated
+// constraints aren't met.
+type HttpServerOption_HealthMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m HttpServerOption_HealthMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m HttpServerOption_HealthMultiError) AllErrors() []error { return m }
+
+// HttpServerOption_HealthValidationError is the validation error returned by
+// HttpServerOption_Health.Validate if the designated constraints aren't met.
+type HttpServerOption_HealthValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HttpServerOption_HealthValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HttpServerOption_HealthValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HttpServerOption_HealthValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HttpServerOption_HealthValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HttpServerOption_HealthValidationError) ErrorName() string {
+	return "HttpServerOption_HealthValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e HttpServerOption_HealthValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHttpServerOption_Health.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HttpServerOption_HealthValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HttpServerOption_HealthValidationError{}
+
+// Validate checks the field values on HttpServerOption_Admin with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *HttpServerOption_Admin) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on HttpServerOption_Admin with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// HttpServerOption_AdminMultiError, or nil if none found.
+func (m *HttpServerOption_Admin) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *HttpServerOption_Admin) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.Disable != nil {
+		// no validation rules for Disable
+	}
+
+	if m.Path != nil {
+		// no validation rules for Path
+	}
+
+	if m.Token != nil {
+		// no validation rules for Token
+	}
+
+	if len(errors) > 0 {
+		return HttpServerOption_AdminMultiError(errors)
+	}
+
+	return nil
+}
+
+// HttpServerOption_AdminMultiError is an error wrapping multiple validation
+// errors returned by HttpServerOption_Admin.ValidateAll() if the designated
+// constraints aren't met.
+type HttpServerOption_AdminMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m HttpServerOption_AdminMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m HttpServerOption_AdminMultiError) AllErrors() []error { return m }
+
+// HttpServerOption_AdminValidationError is the validation error returned by
+// HttpServerOption_Admin.Validate if the designated constraints aren't met.
+type HttpServerOption_AdminValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HttpServerOption_AdminValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HttpServerOption_AdminValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HttpServerOption_AdminValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HttpServerOption_AdminValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HttpServerOption_AdminValidationError) ErrorName() string {
+	return "HttpServerOption_AdminValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e HttpServerOption_AdminValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHttpServerOption_Admin.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HttpServerOption_AdminValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HttpServerOption_AdminValidationError{}
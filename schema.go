@@ -0,0 +1,11 @@
+// Package kratos_foundation 内嵌框架配置的 JSON Schema
+package kratos_foundation
+
+import _ "embed"
+
+// ConfigSchema 框架配置（kratos_foundation_pb.Config）的 JSON Schema，由 make proto 生成
+//
+// 编辑器通过 config.schema.json 提示和校验配置文件，pkg/config 在加载配置时用它校验每个配置文档。
+//
+//go:embed config.schema.json
+var ConfigSchema []byte